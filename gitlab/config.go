@@ -1,23 +1,38 @@
 package gitlab
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"log"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
 	"github.com/xanzy/go-gitlab"
+	"golang.org/x/time/rate"
 )
 
 // Config is per-provider, specifies where to connect to gitlab
 type Config struct {
-	Token      string
-	BaseURL    string
-	Insecure   bool
-	CACertFile string
-	ClientCert string
-	ClientKey  string
+	Token             string
+	BaseURL           string
+	Insecure          bool
+	CACertFile        string
+	ClientCert        string
+	ClientKey         string
+	MaxRetries        int
+	RetryWaitMin      time.Duration
+	RetryWaitMax      time.Duration
+	RequestsPerSecond int
 }
 
 // Client returns a *gitlab.Client to interact with the configured gitlab instance
@@ -51,16 +66,41 @@ func (c *Config) Client() (*gitlab.Client, error) {
 		tlsConfig.Certificates = []tls.Certificate{clientPair}
 	}
 
+	if c.RetryWaitMax < c.RetryWaitMin {
+		return nil, fmt.Errorf("retry_wait_max (%s) must not be lower than retry_wait_min (%s)", c.RetryWaitMax, c.RetryWaitMin)
+	}
+
 	t := http.DefaultTransport.(*http.Transport).Clone()
 	t.TLSClientConfig = tlsConfig
 	t.MaxIdleConnsPerHost = 100
 
+	// The retries are handled by our own retryablehttp client in the transport,
+	// so that the retry count, the wait bounds and the retry policy are configurable.
+	retryClient := &retryablehttp.Client{
+		HTTPClient: &http.Client{
+			Transport: logging.NewTransport("GitLab", t),
+		},
+		RetryMax:       c.MaxRetries,
+		RetryWaitMin:   c.RetryWaitMin,
+		RetryWaitMax:   c.RetryWaitMax,
+		CheckRetry:     retryPolicy,
+		Backoff:        retryBackoff,
+		ErrorHandler:   retryablehttp.PassthroughErrorHandler,
+		RequestLogHook: retryLogHook,
+	}
+
 	opts := []gitlab.ClientOptionFunc{
 		gitlab.WithHTTPClient(
 			&http.Client{
-				Transport: logging.NewTransport("GitLab", t),
+				Transport: &retryablehttp.RoundTripper{Client: retryClient},
 			},
 		),
+		gitlab.WithoutRetries(),
+	}
+
+	// Without an explicit limit, go-gitlab derives one from the RateLimit-Limit header of the instance.
+	if c.RequestsPerSecond > 0 {
+		opts = append(opts, gitlab.WithCustomLimiter(rate.NewLimiter(rate.Limit(c.RequestsPerSecond), c.RequestsPerSecond)))
 	}
 
 	if c.BaseURL != "" {
@@ -79,3 +119,107 @@ func (c *Config) Client() (*gitlab.Client, error) {
 
 	return client, err
 }
+
+// retryPolicy is a retryablehttp.CheckRetry which retries rate limited requests,
+// and retries idempotent requests on connection errors and transient server errors.
+// Other requests are never retried on failure, because they may already have been processed.
+func retryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	// A rate limited request was rejected before being processed, so it is always safe to retry.
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+
+	if !isIdempotentMethod(requestMethod(resp, err)) {
+		return false, nil
+	}
+
+	if err != nil {
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// requestMethod returns the HTTP method of the request that led to the given response or error.
+func requestMethod(resp *http.Response, err error) string {
+	if resp != nil && resp.Request != nil {
+		return resp.Request.Method
+	}
+
+	// The net/http client reports the request method as the operation of the url.Error.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return strings.ToUpper(urlErr.Op)
+	}
+
+	return ""
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryBackoff is a retryablehttp.Backoff which honours the Retry-After and RateLimit-Reset
+// headers sent by GitLab, and otherwise waits for an exponentially growing duration with jitter.
+func retryBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := retryAfter(resp.Header, time.Now()); ok {
+			return wait
+		}
+	}
+
+	// Exponential backoff, bounded by max, of which a random half is kept
+	// to prevent all the resources of an apply from retrying at the same time.
+	backoff := retryablehttp.DefaultBackoff(min, max, attemptNum, nil)
+	wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+	if wait < min {
+		wait = min
+	}
+	return wait
+}
+
+// retryAfter returns how long the server asked us to wait before sending the next request.
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.ParseInt(v, 10, 64); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(v); err == nil {
+			return positiveDuration(date.Sub(now)), true
+		}
+	}
+
+	if v := header.Get("RateLimit-Reset"); v != "" {
+		if reset, err := strconv.ParseInt(v, 10, 64); err == nil && reset > 0 {
+			return positiveDuration(time.Unix(reset, 0).Sub(now)), true
+		}
+	}
+
+	return 0, false
+}
+
+func positiveDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+func retryLogHook(_ retryablehttp.Logger, req *http.Request, attemptNum int) {
+	if attemptNum > 0 {
+		log.Printf("[DEBUG] retrying GitLab request %s %s (attempt %d)", req.Method, req.URL, attemptNum)
+	}
+}
@@ -0,0 +1,151 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Method string
+		Status int
+		Err    error
+		Retry  bool
+	}{
+		{
+			Name:   "rate limited POST",
+			Method: http.MethodPost,
+			Status: http.StatusTooManyRequests,
+			Retry:  true,
+		},
+		{
+			Name:   "bad gateway GET",
+			Method: http.MethodGet,
+			Status: http.StatusBadGateway,
+			Retry:  true,
+		},
+		{
+			Name:   "service unavailable PUT",
+			Method: http.MethodPut,
+			Status: http.StatusServiceUnavailable,
+			Retry:  true,
+		},
+		{
+			Name:   "service unavailable POST",
+			Method: http.MethodPost,
+			Status: http.StatusServiceUnavailable,
+			Retry:  false,
+		},
+		{
+			Name:   "internal server error GET",
+			Method: http.MethodGet,
+			Status: http.StatusInternalServerError,
+			Retry:  false,
+		},
+		{
+			Name:   "not found GET",
+			Method: http.MethodGet,
+			Status: http.StatusNotFound,
+			Retry:  false,
+		},
+		{
+			Name:  "connection error GET",
+			Err:   &url.Error{Op: "Get", URL: "https://gitlab.com/api/v4/projects", Err: errors.New("connection reset by peer")},
+			Retry: true,
+		},
+		{
+			Name:  "connection error POST",
+			Err:   &url.Error{Op: "Post", URL: "https://gitlab.com/api/v4/projects", Err: errors.New("connection reset by peer")},
+			Retry: false,
+		},
+	}
+
+	for _, tc := range cases {
+		var resp *http.Response
+		if tc.Err == nil {
+			resp = &http.Response{
+				StatusCode: tc.Status,
+				Request:    &http.Request{Method: tc.Method},
+			}
+		}
+
+		retry, _ := retryPolicy(context.Background(), resp, tc.Err)
+		if retry != tc.Retry {
+			t.Fatalf("%s: got retry %v expected %v", tc.Name, retry, tc.Retry)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Name   string
+		Header http.Header
+		Wait   time.Duration
+		Found  bool
+	}{
+		{
+			Name:   "retry after seconds",
+			Header: http.Header{"Retry-After": []string{"42"}},
+			Wait:   42 * time.Second,
+			Found:  true,
+		},
+		{
+			Name:   "retry after date",
+			Header: http.Header{"Retry-After": []string{now.Add(time.Minute).Format(http.TimeFormat)}},
+			Wait:   time.Minute,
+			Found:  true,
+		},
+		{
+			Name:   "rate limit reset",
+			Header: http.Header{"Ratelimit-Reset": []string{strconv.FormatInt(now.Add(10*time.Second).Unix(), 10)}},
+			Wait:   10 * time.Second,
+			Found:  true,
+		},
+		{
+			Name:   "rate limit reset in the past",
+			Header: http.Header{"Ratelimit-Reset": []string{strconv.FormatInt(now.Add(-10*time.Second).Unix(), 10)}},
+			Wait:   0,
+			Found:  true,
+		},
+		{
+			Name:   "no header",
+			Header: http.Header{},
+			Found:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		wait, found := retryAfter(tc.Header, now)
+		if found != tc.Found || wait != tc.Wait {
+			t.Fatalf("%s: got %v, %v expected %v, %v", tc.Name, wait, found, tc.Wait, tc.Found)
+		}
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	min := time.Second
+	max := 30 * time.Second
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := retryBackoff(min, max, attempt, nil)
+		if wait < min || wait > max {
+			t.Fatalf("attempt %d: got wait %v expected it between %v and %v", attempt, wait, min, max)
+		}
+	}
+
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"120"}},
+	}
+	if wait := retryBackoff(min, max, 0, resp); wait != 120*time.Second {
+		t.Fatalf("got wait %v expected the Retry-After header to be honoured", wait)
+	}
+}
@@ -3,8 +3,10 @@ package gitlab
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/httpclient"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
@@ -52,6 +54,34 @@ func Provider() terraform.ResourceProvider {
 				Default:     "",
 				Description: descriptions["client_key"],
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				Description:  descriptions["max_retries"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				Description:  descriptions["retry_wait_min"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				Description:  descriptions["retry_wait_max"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"requests_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  descriptions["requests_per_second"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -65,41 +95,72 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"gitlab_branch":                     resourceGitlabBranch(),
-			"gitlab_branch_protection":          resourceGitlabBranchProtection(),
-			"gitlab_tag_protection":             resourceGitlabTagProtection(),
-			"gitlab_group":                      resourceGitlabGroup(),
-			"gitlab_project":                    resourceGitlabProject(),
-			"gitlab_label":                      resourceGitlabLabel(),
-			"gitlab_group_label":                resourceGitlabGroupLabel(),
-			"gitlab_pipeline_schedule":          resourceGitlabPipelineSchedule(),
-			"gitlab_pipeline_schedule_variable": resourceGitlabPipelineScheduleVariable(),
-			"gitlab_pipeline_trigger":           resourceGitlabPipelineTrigger(),
-			"gitlab_project_hook":               resourceGitlabProjectHook(),
-			"gitlab_deploy_key":                 resourceGitlabDeployKey(),
-			"gitlab_deploy_key_enable":          resourceGitlabDeployEnableKey(),
-			"gitlab_deploy_token":               resourceGitlabDeployToken(),
-			"gitlab_user":                       resourceGitlabUser(),
-			"gitlab_project_membership":         resourceGitlabProjectMembership(),
-			"gitlab_group_membership":           resourceGitlabGroupMembership(),
-			"gitlab_project_variable":           resourceGitlabProjectVariable(),
-			"gitlab_group_variable":             resourceGitlabGroupVariable(),
-			"gitlab_project_cluster":            resourceGitlabProjectCluster(),
-			"gitlab_service_slack":              resourceGitlabServiceSlack(),
-			"gitlab_service_jira":               resourceGitlabServiceJira(),
-			"gitlab_service_github":             resourceGitlabServiceGithub(),
-			"gitlab_service_pipelines_email":    resourceGitlabServicePipelinesEmail(),
-			"gitlab_project_share_group":        resourceGitlabProjectShareGroup(),
-			"gitlab_group_cluster":              resourceGitlabGroupCluster(),
-			"gitlab_group_ldap_link":            resourceGitlabGroupLdapLink(),
-			"gitlab_instance_cluster":           resourceGitlabInstanceCluster(),
-			"gitlab_project_mirror":             resourceGitlabProjectMirror(),
-			"gitlab_project_level_mr_approvals": resourceGitlabProjectLevelMRApprovals(),
-			"gitlab_project_approval_rule":      resourceGitlabProjectApprovalRule(),
-			"gitlab_instance_variable":          resourceGitlabInstanceVariable(),
-			"gitlab_project_freeze_period":      resourceGitlabProjectFreezePeriod(),
-			"gitlab_group_share_group":          resourceGitlabGroupShareGroup(),
-			"gitlab_project_badge":              resourceGitlabProjectBadge(),
+			"gitlab_branch":                        resourceGitlabBranch(),
+			"gitlab_branch_protection":             resourceGitlabBranchProtection(),
+			"gitlab_tag_protection":                resourceGitlabTagProtection(),
+			"gitlab_group":                         resourceGitlabGroup(),
+			"gitlab_project":                       resourceGitlabProject(),
+			"gitlab_label":                         resourceGitlabLabel(),
+			"gitlab_group_label":                   resourceGitlabGroupLabel(),
+			"gitlab_pipeline_schedule":             resourceGitlabPipelineSchedule(),
+			"gitlab_pipeline_schedule_variable":    resourceGitlabPipelineScheduleVariable(),
+			"gitlab_pipeline_trigger":              resourceGitlabPipelineTrigger(),
+			"gitlab_project_hook":                  resourceGitlabProjectHook(),
+			"gitlab_deploy_key":                    resourceGitlabDeployKey(),
+			"gitlab_deploy_key_enable":             resourceGitlabDeployEnableKey(),
+			"gitlab_deploy_token":                  resourceGitlabDeployToken(),
+			"gitlab_user":                          resourceGitlabUser(),
+			"gitlab_project_membership":            resourceGitlabProjectMembership(),
+			"gitlab_project_members":               resourceGitlabProjectMembers(),
+			"gitlab_group_membership":              resourceGitlabGroupMembership(),
+			"gitlab_group_members":                 resourceGitlabGroupMembers(),
+			"gitlab_project_variable":              resourceGitlabProjectVariable(),
+			"gitlab_group_variable":                resourceGitlabGroupVariable(),
+			"gitlab_project_cluster":               resourceGitlabProjectCluster(),
+			"gitlab_service_slack":                 resourceGitlabServiceSlack(),
+			"gitlab_service_jira":                  resourceGitlabServiceJira(),
+			"gitlab_service_github":                resourceGitlabServiceGithub(),
+			"gitlab_service_pipelines_email":       resourceGitlabServicePipelinesEmail(),
+			"gitlab_service_microsoft_teams":       resourceGitlabServiceMicrosoftTeams(),
+			"gitlab_service_mattermost":            resourceGitlabServiceMattermost(),
+			"gitlab_service_discord":               resourceGitlabServiceDiscord(),
+			"gitlab_service_jenkins":               resourceGitlabServiceJenkins(),
+			"gitlab_service_emails_on_push":        resourceGitlabServiceEmailsOnPush(),
+			"gitlab_service_custom_issue_tracker":  resourceGitlabServiceCustomIssueTracker(),
+			"gitlab_project_integration":           resourceGitlabProjectIntegration(),
+			"gitlab_project_share_group":           resourceGitlabProjectShareGroup(),
+			"gitlab_group_cluster":                 resourceGitlabGroupCluster(),
+			"gitlab_group_ldap_link":               resourceGitlabGroupLdapLink(),
+			"gitlab_instance_cluster":              resourceGitlabInstanceCluster(),
+			"gitlab_project_mirror":                resourceGitlabProjectMirror(),
+			"gitlab_project_level_mr_approvals":    resourceGitlabProjectLevelMRApprovals(),
+			"gitlab_project_approval_rule":         resourceGitlabProjectApprovalRule(),
+			"gitlab_instance_variable":             resourceGitlabInstanceVariable(),
+			"gitlab_project_freeze_period":         resourceGitlabProjectFreezePeriod(),
+			"gitlab_group_share_group":             resourceGitlabGroupShareGroup(),
+			"gitlab_project_badge":                 resourceGitlabProjectBadge(),
+			"gitlab_group_hook":                    resourceGitlabGroupHook(),
+			"gitlab_system_hook":                   resourceGitlabSystemHook(),
+			"gitlab_project_push_rules":            resourceGitlabProjectPushRules(),
+			"gitlab_group_push_rules":              resourceGitlabGroupPushRules(),
+			"gitlab_project_access_token":          resourceGitlabProjectAccessToken(),
+			"gitlab_group_access_token":            resourceGitlabGroupAccessToken(),
+			"gitlab_personal_access_token":         resourceGitlabPersonalAccessToken(),
+			"gitlab_user_ssh_key":                  resourceGitlabUserSSHKey(),
+			"gitlab_user_gpg_key":                  resourceGitlabUserGPGKey(),
+			"gitlab_project_environment":           resourceGitlabProjectEnvironment(),
+			"gitlab_project_protected_environment": resourceGitlabProjectProtectedEnvironment(),
+			"gitlab_repository_file":               resourceGitlabRepositoryFile(),
+			"gitlab_tag":                           resourceGitlabTag(),
+			"gitlab_release":                       resourceGitlabRelease(),
+			"gitlab_runner":                        resourceGitlabRunner(),
+			"gitlab_project_runner_enablement":     resourceGitlabProjectRunnerEnablement(),
+			"gitlab_application_settings":          resourceGitlabApplicationSettings(),
+			"gitlab_group_badge":                   resourceGitlabGroupBadge(),
+			"gitlab_project_milestone":             resourceGitlabProjectMilestone(),
+			"gitlab_group_milestone":               resourceGitlabGroupMilestone(),
+			"gitlab_project_issue_board":           resourceGitlabProjectIssueBoard(),
+			"gitlab_group_issue_board":             resourceGitlabGroupIssueBoard(),
 		},
 	}
 
@@ -125,6 +186,14 @@ func init() {
 		"client_cert": "File path to client certificate when GitLab instance is behind company proxy. File  must contain PEM encoded data.",
 
 		"client_key": "File path to client key when GitLab instance is behind company proxy. File must contain PEM encoded data.",
+
+		"max_retries": "The maximum number of times a rate limited request, or an idempotent request failing with a transient error, is retried.",
+
+		"retry_wait_min": "The minimum time in seconds to wait before retrying a request.",
+
+		"retry_wait_max": "The maximum time in seconds to wait before retrying a request, unless GitLab asks for a longer wait.",
+
+		"requests_per_second": "The maximum number of requests per second sent to GitLab. 0 means the limit is derived from the rate limit of the GitLab instance.",
 	}
 }
 
@@ -136,6 +205,11 @@ func providerConfigure(p *schema.Provider, d *schema.ResourceData) (interface{},
 		Insecure:   d.Get("insecure").(bool),
 		ClientCert: d.Get("client_cert").(string),
 		ClientKey:  d.Get("client_key").(string),
+
+		MaxRetries:        d.Get("max_retries").(int),
+		RetryWaitMin:      time.Duration(d.Get("retry_wait_min").(int)) * time.Second,
+		RetryWaitMax:      time.Duration(d.Get("retry_wait_max").(int)) * time.Second,
+		RequestsPerSecond: d.Get("requests_per_second").(int),
 	}
 
 	client, err := config.Client()
@@ -0,0 +1,341 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// applicationSettingsID is the ID of the singleton application settings resource.
+const applicationSettingsID = "gitlab"
+
+var visibilityLevels = []string{"private", "internal", "public"}
+
+// The application settings always exist, so every attribute is optional and computed:
+// only the configured attributes are managed, and the others are left as they are.
+func resourceGitlabApplicationSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabApplicationSettingsCreate,
+		Read:   resourceGitlabApplicationSettingsRead,
+		Update: resourceGitlabApplicationSettingsUpdate,
+		Delete: resourceGitlabApplicationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"signup_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"send_user_confirmation_email": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"after_sign_up_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"domain_whitelist": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"domain_blacklist_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"domain_blacklist": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"user_default_external": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"default_project_visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateValueFunc(visibilityLevels),
+			},
+			"default_group_visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateValueFunc(visibilityLevels),
+			},
+			"default_snippet_visibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateValueFunc(visibilityLevels),
+			},
+			"restricted_visibility_levels": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateValueFunc(visibilityLevels),
+				},
+				Set: schema.HashString,
+			},
+			"default_branch_protection": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 3),
+			},
+			"default_project_creation": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 2),
+			},
+			"default_projects_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"require_two_factor_authentication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"two_factor_grace_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"password_authentication_enabled_for_web": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"password_authentication_enabled_for_git": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_local_requests_from_web_hooks_and_services": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_local_requests_from_system_hooks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"outbound_local_requests_whitelist": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"gravatar_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"project_export_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"max_attachment_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceGitlabApplicationSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] update gitlab application settings")
+
+	if _, _, err := client.Settings.UpdateSettings(expandApplicationSettingsOptions(d, true)); err != nil {
+		return err
+	}
+
+	d.SetId(applicationSettingsID)
+
+	return resourceGitlabApplicationSettingsRead(d, meta)
+}
+
+func resourceGitlabApplicationSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read gitlab application settings")
+
+	settings, _, err := client.Settings.GetSettings()
+	if err != nil {
+		return err
+	}
+
+	d.Set("signup_enabled", settings.SignupEnabled)
+	d.Set("send_user_confirmation_email", settings.SendUserConfirmationEmail)
+	d.Set("after_sign_up_text", settings.AfterSignUpText)
+	if err := d.Set("domain_whitelist", settings.DomainWhitelist); err != nil {
+		return fmt.Errorf("error setting domain_whitelist: %v", err)
+	}
+	d.Set("domain_blacklist_enabled", settings.DomainBlacklistEnabled)
+	if err := d.Set("domain_blacklist", settings.DomainBlacklist); err != nil {
+		return fmt.Errorf("error setting domain_blacklist: %v", err)
+	}
+	d.Set("user_default_external", settings.UserDefaultExternal)
+	d.Set("default_project_visibility", string(settings.DefaultProjectVisibility))
+	d.Set("default_group_visibility", string(settings.DefaultGroupVisibility))
+	d.Set("default_snippet_visibility", string(settings.DefaultSnippetVisibility))
+	restrictedVisibilityLevels := make([]string, 0, len(settings.RestrictedVisibilityLevels))
+	for _, level := range settings.RestrictedVisibilityLevels {
+		restrictedVisibilityLevels = append(restrictedVisibilityLevels, string(level))
+	}
+	if err := d.Set("restricted_visibility_levels", restrictedVisibilityLevels); err != nil {
+		return fmt.Errorf("error setting restricted_visibility_levels: %v", err)
+	}
+	d.Set("default_branch_protection", settings.DefaultBranchProtection)
+	d.Set("default_project_creation", settings.DefaultProjectCreation)
+	d.Set("default_projects_limit", settings.DefaultProjectsLimit)
+	d.Set("require_two_factor_authentication", settings.RequireTwoFactorAuthentication)
+	d.Set("two_factor_grace_period", settings.TwoFactorGracePeriod)
+	d.Set("password_authentication_enabled_for_web", settings.PasswordAuthenticationEnabledForWeb)
+	d.Set("password_authentication_enabled_for_git", settings.PasswordAuthenticationEnabledForGit)
+	d.Set("allow_local_requests_from_web_hooks_and_services", settings.AllowLocalRequestsFromWebHooksAndServices)
+	d.Set("allow_local_requests_from_system_hooks", settings.AllowLocalRequestsFromSystemHooks)
+	if err := d.Set("outbound_local_requests_whitelist", settings.OutboundLocalRequestsWhitelist); err != nil {
+		return fmt.Errorf("error setting outbound_local_requests_whitelist: %v", err)
+	}
+	d.Set("gravatar_enabled", settings.GravatarEnabled)
+	d.Set("project_export_enabled", settings.ProjectExportEnabled)
+	d.Set("max_attachment_size", settings.MaxAttachmentSize)
+	return nil
+}
+
+func resourceGitlabApplicationSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] update gitlab application settings")
+
+	if _, _, err := client.Settings.UpdateSettings(expandApplicationSettingsOptions(d, false)); err != nil {
+		return err
+	}
+
+	return resourceGitlabApplicationSettingsRead(d, meta)
+}
+
+// The application settings cannot be deleted, so destroying the resource only removes it from the state.
+func resourceGitlabApplicationSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] gitlab application settings cannot be deleted, only removing them from state")
+	return nil
+}
+
+// expandApplicationSettingsOptions returns the options to update the configured settings.
+// On create, these are all the settings in the configuration, and on update only the changed ones.
+func expandApplicationSettingsOptions(d *schema.ResourceData, create bool) *gitlab.UpdateSettingsOptions {
+	options := &gitlab.UpdateSettingsOptions{}
+
+	configured := func(key string) (interface{}, bool) {
+		// Unlike GetOk, GetOkExists tells unset settings apart from settings which are set to false or zero.
+		v, ok := d.GetOkExists(key) // nolint // TODO: GetOkExists is deprecated, but has no replacement in this SDK version
+		return v, ok && (create || d.HasChange(key))
+	}
+
+	if v, ok := configured("signup_enabled"); ok {
+		options.SignupEnabled = gitlab.Bool(v.(bool))
+	}
+	if v, ok := configured("send_user_confirmation_email"); ok {
+		options.SendUserConfirmationEmail = gitlab.Bool(v.(bool))
+	}
+	if v, ok := configured("after_sign_up_text"); ok {
+		options.AfterSignUpText = gitlab.String(v.(string))
+	}
+	if v, ok := configured("domain_whitelist"); ok {
+		options.DomainWhitelist = *stringSetToStringSlice(v.(*schema.Set))
+	}
+	if v, ok := configured("domain_blacklist_enabled"); ok {
+		options.DomainBlacklistEnabled = gitlab.Bool(v.(bool))
+	}
+	if v, ok := configured("domain_blacklist"); ok {
+		options.DomainBlacklist = *stringSetToStringSlice(v.(*schema.Set))
+	}
+	if v, ok := configured("user_default_external"); ok {
+		options.UserDefaultExternal = gitlab.Bool(v.(bool))
+	}
+	if v, ok := configured("default_project_visibility"); ok {
+		options.DefaultProjectVisibility = stringToVisibilityLevel(v.(string))
+	}
+	if v, ok := configured("default_group_visibility"); ok {
+		options.DefaultGroupVisibility = stringToVisibilityLevel(v.(string))
+	}
+	if v, ok := configured("default_snippet_visibility"); ok {
+		options.DefaultSnippetVisibility = stringToVisibilityLevel(v.(string))
+	}
+	if v, ok := configured("restricted_visibility_levels"); ok {
+		for _, level := range *stringSetToStringSlice(v.(*schema.Set)) {
+			options.RestrictedVisibilityLevels = append(options.RestrictedVisibilityLevels, gitlab.VisibilityValue(level))
+		}
+	}
+	if v, ok := configured("default_branch_protection"); ok {
+		options.DefaultBranchProtection = gitlab.Int(v.(int))
+	}
+	if v, ok := configured("default_project_creation"); ok {
+		options.DefaultProjectCreation = gitlab.Int(v.(int))
+	}
+	if v, ok := configured("default_projects_limit"); ok {
+		options.DefaultProjectsLimit = gitlab.Int(v.(int))
+	}
+	if v, ok := configured("require_two_factor_authentication"); ok {
+		options.RequireTwoFactorAuthentication = gitlab.Bool(v.(bool))
+	}
+	if v, ok := configured("two_factor_grace_period"); ok {
+		options.TwoFactorGracePeriod = gitlab.Int(v.(int))
+	}
+	if v, ok := configured("password_authentication_enabled_for_web"); ok {
+		options.PasswordAuthenticationEnabledForWeb = gitlab.Bool(v.(bool))
+	}
+	if v, ok := configured("password_authentication_enabled_for_git"); ok {
+		options.PasswordAuthenticationEnabledForGit = gitlab.Bool(v.(bool))
+	}
+	if v, ok := configured("allow_local_requests_from_web_hooks_and_services"); ok {
+		options.AllowLocalRequestsFromWebHooksAndServices = gitlab.Bool(v.(bool))
+	}
+	if v, ok := configured("allow_local_requests_from_system_hooks"); ok {
+		options.AllowLocalRequestsFromSystemHooks = gitlab.Bool(v.(bool))
+	}
+	if v, ok := configured("outbound_local_requests_whitelist"); ok {
+		options.OutboundLocalRequestsWhitelist = *stringSetToStringSlice(v.(*schema.Set))
+	}
+	if v, ok := configured("gravatar_enabled"); ok {
+		options.GravatarEnabled = gitlab.Bool(v.(bool))
+	}
+	if v, ok := configured("project_export_enabled"); ok {
+		options.ProjectExportEnabled = gitlab.Bool(v.(bool))
+	}
+	if v, ok := configured("max_attachment_size"); ok {
+		options.MaxAttachmentSize = gitlab.Int(v.(int))
+	}
+
+	return options
+}
@@ -0,0 +1,87 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabApplicationSettings_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+
+	// Destroying the resource leaves the settings as they are, so they are restored by the test.
+	settings, _, err := client.Settings.GetSettings()
+	if err != nil {
+		t.Fatalf("failed to get application settings: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _, err := client.Settings.UpdateSettings(&gitlab.UpdateSettingsOptions{
+			AfterSignUpText:                gitlab.String(settings.AfterSignUpText),
+			DefaultSnippetVisibility:       gitlab.Visibility(settings.DefaultSnippetVisibility),
+			OutboundLocalRequestsWhitelist: settings.OutboundLocalRequestsWhitelist,
+			GravatarEnabled:                gitlab.Bool(settings.GravatarEnabled),
+		})
+		if err != nil {
+			t.Fatalf("failed to restore application settings: %v", err)
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// Manage some settings
+			{
+				Config: testAccGitlabApplicationSettingsConfig("Welcome!", "private", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "after_sign_up_text", "Welcome!"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "default_snippet_visibility", "private"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "outbound_local_requests_whitelist.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "gravatar_enabled", "false"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_application_settings.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Detect a setting changed outside of terraform
+			{
+				PreConfig: func() {
+					_, _, err := client.Settings.UpdateSettings(&gitlab.UpdateSettingsOptions{
+						AfterSignUpText: gitlab.String("Changed by hand"),
+					})
+					if err != nil {
+						t.Fatalf("failed to update application settings: %v", err)
+					}
+				},
+				Config:             testAccGitlabApplicationSettingsConfig("Welcome!", "private", false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update the settings
+			{
+				Config: testAccGitlabApplicationSettingsConfig("Hello!", "internal", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "after_sign_up_text", "Hello!"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "default_snippet_visibility", "internal"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "gravatar_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGitlabApplicationSettingsConfig(afterSignUpText, snippetVisibility string, gravatarEnabled bool) string {
+	return fmt.Sprintf(`
+resource "gitlab_application_settings" "this" {
+  after_sign_up_text                = %q
+  default_snippet_visibility        = %q
+  outbound_local_requests_whitelist = ["127.0.0.1"]
+  gravatar_enabled                  = %t
+}`, afterSignUpText, snippetVisibility, gravatarEnabled)
+}
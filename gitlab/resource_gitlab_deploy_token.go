@@ -14,7 +14,7 @@ import (
 
 func resourceGitlabDeployToken() *schema.Resource {
 	// lintignore: XR002 // TODO: Resolve this tfproviderlint issue
-	return &schema.Resource{
+	return withTokenRotation(&schema.Resource{
 		Create: resourceGitlabDeployTokenCreate,
 		Read:   resourceGitlabDeployTokenRead,
 		Delete: resourceGitlabDeployTokenDelete,
@@ -66,7 +66,7 @@ func resourceGitlabDeployToken() *schema.Resource {
 				Sensitive: true,
 			},
 		},
-	}
+	})
 }
 
 func expiresAtSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
@@ -132,6 +132,7 @@ func resourceGitlabDeployTokenCreate(d *schema.ResourceData, meta interface{}) e
 	// Token is only available on creation
 	d.Set("token", deployToken.Token)
 	d.Set("username", deployToken.Username)
+	setTokenRotationState(d)
 
 	return nil
 }
@@ -167,6 +168,7 @@ func resourceGitlabDeployTokenRead(d *schema.ResourceData, meta interface{}) err
 			if token.ExpiresAt != nil {
 				d.Set("expires_at", token.ExpiresAt) // lintignore: R004,XR004 // TODO: Resolve this tfproviderlint issue
 			}
+			setTokenRotationState(d)
 
 			for _, scope := range token.Scopes {
 				if scope == "read_repository" {
@@ -6,6 +6,7 @@ import (
 	"log"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -116,7 +117,6 @@ func resourceGitlabGroup() *schema.Resource {
 			"parent_id": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Default:  "",
 			},
 			"runners_token": {
@@ -280,13 +280,27 @@ func resourceGitlabGroupRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("auto_devops_enabled", group.AutoDevopsEnabled)
 	d.Set("emails_disabled", group.EmailsDisabled)
 	d.Set("mentions_disabled", group.MentionsDisabled)
-	d.Set("parent_id", group.ParentID)
+	d.Set("parent_id", flattenParentID(d, group))
 	d.Set("runners_token", group.RunnersToken)
 	d.Set("share_with_group_lock", group.ShareWithGroupLock)
 
 	return nil
 }
 
+// flattenParentID returns the parent of the group in the same form, ID or full path, as it is configured.
+func flattenParentID(d *schema.ResourceData, group *gitlab.Group) string {
+	if group.ParentID == 0 {
+		return ""
+	}
+	parentID := d.Get("parent_id").(string)
+	if parentID != "" {
+		if _, err := strconv.Atoi(parentID); err != nil {
+			return strings.TrimSuffix(group.FullPath, "/"+group.Path)
+		}
+	}
+	return fmt.Sprintf("%d", group.ParentID)
+}
+
 func resourceGitlabGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 
@@ -357,9 +371,54 @@ func resourceGitlabGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if d.HasChange("parent_id") {
+		parentID, err := readParentID(d.Get("parent_id").(string), meta)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] transferring group %s to parent %v", d.Id(), d.Get("parent_id"))
+
+		if err := transferGroup(client, d.Id(), parentID); err != nil {
+			return fmt.Errorf("error transferring group %s to parent %q: %w", d.Id(), d.Get("parent_id").(string), err)
+		}
+	}
+
 	return resourceGitlabGroupRead(d, meta)
 }
 
+// groupTransferMinimumVersion is the first version of GitLab with the group transfer API.
+const groupTransferMinimumVersion = "14.6"
+
+// transferGroupOptions represents the available options to transfer a group.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/groups.html#transfer-a-group-to-a-new-parent-group--turn-a-subgroup-to-a-top-level-group
+type transferGroupOptions struct {
+	GroupID *int `url:"group_id,omitempty" json:"group_id,omitempty"`
+}
+
+// transferGroup moves a group to a new parent group, or to the top level when parentID is nil.
+// The group transfer API is not supported by go-gitlab yet, so the request is built here.
+func transferGroup(client *gitlab.Client, gid string, parentID *int) error {
+	isAtLeast, err := isGitLabVersionAtLeast(client, groupTransferMinimumVersion)()
+	if err != nil {
+		return err
+	}
+	if !isAtLeast {
+		return fmt.Errorf("changing the parent_id of a group requires GitLab %s or newer", groupTransferMinimumVersion)
+	}
+
+	u := fmt.Sprintf("groups/%s/transfer", url.PathEscape(gid))
+
+	req, err := client.NewRequest(http.MethodPost, u, &transferGroupOptions{GroupID: parentID}, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
 func resourceGitlabGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*gitlab.Client)
 	log.Printf("[DEBUG] Delete gitlab group %s", d.Id())
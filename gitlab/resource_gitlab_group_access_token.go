@@ -0,0 +1,97 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// https://docs.gitlab.com/ee/api/group_access_tokens.html
+
+func resourceGitlabGroupAccessToken() *schema.Resource {
+	return withTokenRotation(&schema.Resource{
+		Create: resourceGitlabGroupAccessTokenCreate,
+		Read:   resourceGitlabGroupAccessTokenRead,
+		Delete: resourceGitlabGroupAccessTokenDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: accessTokenSchema("group"),
+	})
+}
+
+func resourceGitlabGroupAccessTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+	options, err := expandAccessTokenOptions(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] create gitlab group access token %q in group %s", *options.Name, group)
+
+	token, err := createAccessToken(client, fmt.Sprintf("groups/%s/access_tokens", url.PathEscape(group)), options)
+	if err != nil {
+		return err
+	}
+
+	tokenID := strconv.Itoa(token.ID)
+	d.SetId(buildTwoPartID(&group, &tokenID))
+
+	// The token is only available on creation
+	d.Set("token", token.Token)
+
+	return resourceGitlabGroupAccessTokenRead(d, meta)
+}
+
+func resourceGitlabGroupAccessTokenRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, tokenID, err := parseAccessTokenID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab group access token %d in group %s", tokenID, group)
+
+	token, err := getAccessToken(client, fmt.Sprintf("groups/%s/access_tokens", url.PathEscape(group)), tokenID)
+	if err != nil {
+		if is404(err) || errors.Is(err, errAccessTokenNotFound) {
+			log.Printf("[DEBUG] gitlab group access token %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("group", group)
+	return setAccessTokenState(d, token)
+}
+
+func resourceGitlabGroupAccessTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, tokenID, err := parseAccessTokenID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Delete gitlab group access token %d in group %s", tokenID, group)
+
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("groups/%s/access_tokens/%d", url.PathEscape(group), tokenID), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGitlabGroupAccessToken_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+
+	// Group access tokens are available since GitLab 14.7.
+	if isOld, err := isGitLabVersionLessThan(client, "14.7")(); err != nil {
+		t.Fatalf("could not check GitLab version: %v", err)
+	} else if isOld {
+		t.Skip("Test is skipped for GitLab versions older than 14.7")
+	}
+
+	group := testAccCreateGroups(t, client, 1)[0]
+	expiresAt := time.Now().AddDate(0, 1, 0).Format("2006-01-02")
+	newExpiresAt := time.Now().AddDate(0, 2, 0).Format("2006-01-02")
+	var tokenID string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabAccessTokenDestroy(client, "gitlab_group_access_token"),
+		Steps: []resource.TestStep{
+			// Create a group access token
+			{
+				Config: testAccGitlabGroupAccessTokenConfig(group.ID, expiresAt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_group_access_token.foo", "token"),
+					resource.TestCheckResourceAttrSet("gitlab_group_access_token.foo", "user_id"),
+					resource.TestCheckResourceAttr("gitlab_group_access_token.foo", "access_level", "maintainer"),
+					resource.TestCheckResourceAttr("gitlab_group_access_token.foo", "expires_at", expiresAt),
+					resource.TestCheckResourceAttr("gitlab_group_access_token.foo", "active", "true"),
+					testAccCheckGitlabAccessTokenSaveID("gitlab_group_access_token.foo", &tokenID),
+				),
+			},
+			// Verify import, the token is only available on creation
+			{
+				ResourceName:            "gitlab_group_access_token.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Changing the expiry date creates a new token
+			{
+				Config: testAccGitlabGroupAccessTokenConfig(group.ID, newExpiresAt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_access_token.foo", "expires_at", newExpiresAt),
+					testAccCheckGitlabAccessTokenIDChanged("gitlab_group_access_token.foo", &tokenID),
+				),
+			},
+		},
+	})
+}
+
+func testAccGitlabGroupAccessTokenConfig(group int, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group_access_token" "foo" {
+  group      = %d
+  name       = "my-token"
+  scopes     = ["read_repository", "read_api"]
+  expires_at = %q
+}`, group, expiresAt)
+}
@@ -0,0 +1,81 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupBadge_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	group := testAccCreateGroups(t, client, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupBadgeDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a group badge
+			{
+				Config: testAccGitlabGroupBadgeConfig(group.ID, "pipeline"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_badge.foo", "link_url", "https://example.com/%{project_path}/pipeline"),
+					resource.TestCheckResourceAttr("gitlab_group_badge.foo", "image_url", "https://example.com/%{project_path}/pipeline.svg"),
+					resource.TestCheckResourceAttrSet("gitlab_group_badge.foo", "rendered_link_url"),
+					resource.TestCheckResourceAttrSet("gitlab_group_badge.foo", "rendered_image_url"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_badge.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the group badge
+			{
+				Config: testAccGitlabGroupBadgeConfig(group.ID, "coverage"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_badge.foo", "link_url", "https://example.com/%{project_path}/coverage"),
+					resource.TestCheckResourceAttr("gitlab_group_badge.foo", "image_url", "https://example.com/%{project_path}/coverage.svg"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupBadgeDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_group_badge" {
+				continue
+			}
+
+			groupID, badgeID, err := parseGroupBadgeID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = client.GroupBadges.GetGroupBadge(groupID, badgeID)
+			if err == nil {
+				return fmt.Errorf("group badge %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabGroupBadgeConfig(group int, name string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group_badge" "foo" {
+  group     = %d
+  link_url  = "https://example.com/%%{project_path}/%s"
+  image_url = "https://example.com/%%{project_path}/%s.svg"
+}`, group, name, name)
+}
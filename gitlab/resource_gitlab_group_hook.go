@@ -0,0 +1,281 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupHook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupHookCreate,
+		Read:   resourceGitlabGroupHookRead,
+		Update: resourceGitlabGroupHookUpdate,
+		Delete: resourceGitlabGroupHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"push_events_branch_filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"issues_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"confidential_issues_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"merge_requests_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tag_push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"note_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"confidential_note_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"job_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"pipeline_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"wiki_page_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"deployment_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"releases_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"subgroup_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enable_ssl_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// groupHook extends gitlab.GroupHook with the attributes not supported by go-gitlab yet.
+type groupHook struct {
+	gitlab.GroupHook
+	PushEventsBranchFilter string `json:"push_events_branch_filter"`
+	SubgroupEvents         bool   `json:"subgroup_events"`
+}
+
+// groupHookOptions extends gitlab.AddGroupHookOptions with the attributes not supported by go-gitlab yet.
+// It is used both to add and to edit a group hook, as both accept the same attributes.
+type groupHookOptions struct {
+	gitlab.AddGroupHookOptions
+	PushEventsBranchFilter *string `url:"push_events_branch_filter,omitempty" json:"push_events_branch_filter,omitempty"`
+	SubgroupEvents         *bool   `url:"subgroup_events,omitempty" json:"subgroup_events,omitempty"`
+}
+
+func resourceGitlabGroupHookCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+	options := expandGroupHookOptions(d)
+
+	if v, ok := d.GetOk("token"); ok {
+		options.Token = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab group hook %q", *options.URL)
+
+	hook, err := doGroupHookRequest(client, http.MethodPost, fmt.Sprintf("groups/%s/hooks", url.PathEscape(group)), options)
+	if err != nil {
+		return err
+	}
+
+	hookID := strconv.Itoa(hook.ID)
+	d.SetId(buildTwoPartID(&group, &hookID))
+
+	return resourceGitlabGroupHookRead(d, meta)
+}
+
+func resourceGitlabGroupHookRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, hookID, err := parseGroupHookID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab group hook %s/%d", group, hookID)
+
+	hook, err := doGroupHookRequest(client, http.MethodGet, fmt.Sprintf("groups/%s/hooks/%d", url.PathEscape(group), hookID), nil)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group hook %s/%d not found so removing from state", group, hookID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("group", group)
+	d.Set("url", hook.URL)
+	d.Set("push_events", hook.PushEvents)
+	d.Set("push_events_branch_filter", hook.PushEventsBranchFilter)
+	d.Set("issues_events", hook.IssuesEvents)
+	d.Set("confidential_issues_events", hook.ConfidentialIssuesEvents)
+	d.Set("merge_requests_events", hook.MergeRequestsEvents)
+	d.Set("tag_push_events", hook.TagPushEvents)
+	d.Set("note_events", hook.NoteEvents)
+	d.Set("confidential_note_events", hook.ConfidentialNoteEvents)
+	d.Set("job_events", hook.JobEvents)
+	d.Set("pipeline_events", hook.PipelineEvents)
+	d.Set("wiki_page_events", hook.WikiPageEvents)
+	d.Set("deployment_events", hook.DeploymentEvents)
+	d.Set("releases_events", hook.ReleasesEvents)
+	d.Set("subgroup_events", hook.SubgroupEvents)
+	d.Set("enable_ssl_verification", hook.EnableSSLVerification)
+	return nil
+}
+
+func resourceGitlabGroupHookUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, hookID, err := parseGroupHookID(d.Id())
+	if err != nil {
+		return err
+	}
+	options := expandGroupHookOptions(d)
+
+	if d.HasChange("token") {
+		options.Token = gitlab.String(d.Get("token").(string))
+	}
+
+	log.Printf("[DEBUG] update gitlab group hook %s", d.Id())
+
+	_, err = doGroupHookRequest(client, http.MethodPut, fmt.Sprintf("groups/%s/hooks/%d", url.PathEscape(group), hookID), options)
+	if err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupHookRead(d, meta)
+}
+
+func resourceGitlabGroupHookDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, hookID, err := parseGroupHookID(d.Id())
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Delete gitlab group hook %s", d.Id())
+
+	_, err = client.Groups.DeleteGroupHook(group, hookID)
+	return err
+}
+
+func expandGroupHookOptions(d *schema.ResourceData) *groupHookOptions {
+	return &groupHookOptions{
+		AddGroupHookOptions: gitlab.AddGroupHookOptions{
+			URL:                      gitlab.String(d.Get("url").(string)),
+			PushEvents:               gitlab.Bool(d.Get("push_events").(bool)),
+			IssuesEvents:             gitlab.Bool(d.Get("issues_events").(bool)),
+			ConfidentialIssuesEvents: gitlab.Bool(d.Get("confidential_issues_events").(bool)),
+			MergeRequestsEvents:      gitlab.Bool(d.Get("merge_requests_events").(bool)),
+			TagPushEvents:            gitlab.Bool(d.Get("tag_push_events").(bool)),
+			NoteEvents:               gitlab.Bool(d.Get("note_events").(bool)),
+			ConfidentialNoteEvents:   gitlab.Bool(d.Get("confidential_note_events").(bool)),
+			JobEvents:                gitlab.Bool(d.Get("job_events").(bool)),
+			PipelineEvents:           gitlab.Bool(d.Get("pipeline_events").(bool)),
+			WikiPageEvents:           gitlab.Bool(d.Get("wiki_page_events").(bool)),
+			DeploymentEvents:         gitlab.Bool(d.Get("deployment_events").(bool)),
+			ReleasesEvents:           gitlab.Bool(d.Get("releases_events").(bool)),
+			EnableSSLVerification:    gitlab.Bool(d.Get("enable_ssl_verification").(bool)),
+		},
+		PushEventsBranchFilter: gitlab.String(d.Get("push_events_branch_filter").(string)),
+		SubgroupEvents:         gitlab.Bool(d.Get("subgroup_events").(bool)),
+	}
+}
+
+func doGroupHookRequest(client *gitlab.Client, method, path string, options *groupHookOptions) (*groupHook, error) {
+	var opt interface{}
+	if options != nil {
+		opt = options
+	}
+
+	req, err := client.NewRequest(method, path, opt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	hook := new(groupHook)
+	if _, err := client.Do(req, hook); err != nil {
+		return nil, err
+	}
+
+	return hook, nil
+}
+
+func parseGroupHookID(id string) (string, int, error) {
+	group, rawHookID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	hookID, err := strconv.Atoi(rawHookID)
+	if err != nil {
+		return "", 0, fmt.Errorf("Unexpected hook ID %q in ID %q: %w", rawHookID, id, err)
+	}
+
+	return group, hookID, nil
+}
@@ -0,0 +1,236 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupHook_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+
+	// Group hooks are only available in GitLab Premium.
+	testAccCheckEE(t, client)
+
+	var hook groupHook
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupHookDestroy,
+		Steps: []resource.TestStep{
+			// Create a group and hook with default options
+			{
+				Config: testAccGitlabGroupHookConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupHookExists("gitlab_group_hook.foo", &hook),
+					testAccCheckGitlabGroupHookAttributes(&hook, &testAccGitlabGroupHookExpectedAttributes{
+						URL:                   fmt.Sprintf("https://example.com/hook-%d", rInt),
+						PushEvents:            true,
+						EnableSSLVerification: true,
+					}),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_group_hook.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Update the group hook to toggle all the values to their inverse
+			{
+				Config: testAccGitlabGroupHookUpdateConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupHookExists("gitlab_group_hook.foo", &hook),
+					testAccCheckGitlabGroupHookAttributes(&hook, &testAccGitlabGroupHookExpectedAttributes{
+						URL:                      fmt.Sprintf("https://example.com/hook-%d", rInt),
+						PushEvents:               true,
+						PushEventsBranchFilter:   "devel",
+						IssuesEvents:             true,
+						ConfidentialIssuesEvents: true,
+						MergeRequestsEvents:      true,
+						TagPushEvents:            true,
+						NoteEvents:               true,
+						ConfidentialNoteEvents:   true,
+						JobEvents:                true,
+						PipelineEvents:           true,
+						WikiPageEvents:           true,
+						DeploymentEvents:         true,
+						ReleasesEvents:           true,
+						SubgroupEvents:           true,
+						EnableSSLVerification:    false,
+					}),
+				),
+			},
+			// Update the group hook to toggle the options back
+			{
+				Config: testAccGitlabGroupHookConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupHookExists("gitlab_group_hook.foo", &hook),
+					testAccCheckGitlabGroupHookAttributes(&hook, &testAccGitlabGroupHookExpectedAttributes{
+						URL:                   fmt.Sprintf("https://example.com/hook-%d", rInt),
+						PushEvents:            true,
+						EnableSSLVerification: true,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupHookExists(n string, hook *groupHook) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		group, hookID, err := parseGroupHookID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		conn := testAccProvider.Meta().(*gitlab.Client)
+
+		gotHook, err := doGroupHookRequest(conn, http.MethodGet, fmt.Sprintf("groups/%s/hooks/%d", url.PathEscape(group), hookID), nil)
+		if err != nil {
+			return err
+		}
+		*hook = *gotHook
+		return nil
+	}
+}
+
+type testAccGitlabGroupHookExpectedAttributes struct {
+	URL                      string
+	PushEvents               bool
+	PushEventsBranchFilter   string
+	IssuesEvents             bool
+	ConfidentialIssuesEvents bool
+	MergeRequestsEvents      bool
+	TagPushEvents            bool
+	NoteEvents               bool
+	ConfidentialNoteEvents   bool
+	JobEvents                bool
+	PipelineEvents           bool
+	WikiPageEvents           bool
+	DeploymentEvents         bool
+	ReleasesEvents           bool
+	SubgroupEvents           bool
+	EnableSSLVerification    bool
+}
+
+func testAccCheckGitlabGroupHookAttributes(hook *groupHook, want *testAccGitlabGroupHookExpectedAttributes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		got := testAccGitlabGroupHookExpectedAttributes{
+			URL:                      hook.URL,
+			PushEvents:               hook.PushEvents,
+			PushEventsBranchFilter:   hook.PushEventsBranchFilter,
+			IssuesEvents:             hook.IssuesEvents,
+			ConfidentialIssuesEvents: hook.ConfidentialIssuesEvents,
+			MergeRequestsEvents:      hook.MergeRequestsEvents,
+			TagPushEvents:            hook.TagPushEvents,
+			NoteEvents:               hook.NoteEvents,
+			ConfidentialNoteEvents:   hook.ConfidentialNoteEvents,
+			JobEvents:                hook.JobEvents,
+			PipelineEvents:           hook.PipelineEvents,
+			WikiPageEvents:           hook.WikiPageEvents,
+			DeploymentEvents:         hook.DeploymentEvents,
+			ReleasesEvents:           hook.ReleasesEvents,
+			SubgroupEvents:           hook.SubgroupEvents,
+			EnableSSLVerification:    hook.EnableSSLVerification,
+		}
+
+		if got != *want {
+			return fmt.Errorf("got group hook %+v; want %+v", got, *want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGitlabGroupHookDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*gitlab.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_hook" {
+			continue
+		}
+
+		group, hookID, err := parseGroupHookID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = conn.Groups.GetGroupHook(group, hookID)
+		if err == nil {
+			return fmt.Errorf("Group hook %s still exists", rs.Primary.ID)
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccGitlabGroupHookConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo-name-%d"
+  path = "foo-path-%d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_group_hook" "foo" {
+  group = "${gitlab_group.foo.id}"
+  url   = "https://example.com/hook-%d"
+}
+	`, rInt, rInt, rInt)
+}
+
+func testAccGitlabGroupHookUpdateConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo-name-%d"
+  path = "foo-path-%d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_group_hook" "foo" {
+  group                      = "${gitlab_group.foo.id}"
+  url                        = "https://example.com/hook-%d"
+  token                      = "secret-token-%d"
+  enable_ssl_verification    = false
+  push_events                = true
+  push_events_branch_filter  = "devel"
+  issues_events              = true
+  confidential_issues_events = true
+  merge_requests_events      = true
+  tag_push_events            = true
+  note_events                = true
+  confidential_note_events   = true
+  job_events                 = true
+  pipeline_events            = true
+  wiki_page_events           = true
+  deployment_events          = true
+  releases_events            = true
+  subgroup_events            = true
+}
+	`, rInt, rInt, rInt, rInt)
+}
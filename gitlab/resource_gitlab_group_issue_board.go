@@ -0,0 +1,101 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// https://docs.gitlab.com/ee/api/group_boards.html
+
+func resourceGitlabGroupIssueBoard() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupIssueBoardCreate,
+		Read:   resourceGitlabGroupIssueBoardRead,
+		Update: resourceGitlabGroupIssueBoardUpdate,
+		Delete: resourceGitlabGroupIssueBoardDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: issueBoardSchema("group"),
+	}
+}
+
+func resourceGitlabGroupIssueBoardCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	log.Printf("[DEBUG] create gitlab issue board %q in group %s", d.Get("name").(string), group)
+
+	board, err := createIssueBoard(d, client, fmt.Sprintf("groups/%s/boards", url.PathEscape(group)))
+	if board != nil {
+		boardID := strconv.Itoa(board.ID)
+		d.SetId(buildTwoPartID(&group, &boardID))
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupIssueBoardRead(d, meta)
+}
+
+func resourceGitlabGroupIssueBoardRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, boardID, err := parseIssueBoardID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab issue board %d in group %s", boardID, group)
+
+	board, err := getIssueBoard(client, fmt.Sprintf("groups/%s/boards/%d", url.PathEscape(group), boardID))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group issue board %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("group", group)
+	return setIssueBoardState(d, board)
+}
+
+func resourceGitlabGroupIssueBoardUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, boardID, err := parseIssueBoardID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] update gitlab group issue board %s", d.Id())
+
+	if err := updateIssueBoard(d, client, fmt.Sprintf("groups/%s/boards/%d", url.PathEscape(group), boardID)); err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupIssueBoardRead(d, meta)
+}
+
+func resourceGitlabGroupIssueBoardDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, boardID, err := parseIssueBoardID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Delete gitlab group issue board %s", d.Id())
+
+	_, err = client.GroupIssueBoards.DeleteIssueBoard(group, boardID)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccGitlabGroupIssueBoard_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	group := testAccCreateGroups(t, client, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabIssueBoardDestroy(client, "gitlab_group_issue_board"),
+		Steps: []resource.TestStep{
+			// Create a group board with label lists
+			{
+				Config: testAccGitlabGroupIssueBoardConfig(group.ID, "todo", "doing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_issue_board.foo", "name", "Kanban"),
+					resource.TestCheckResourceAttrPair("gitlab_group_issue_board.foo", "lists.0.label_id", "gitlab_group_label.todo", "label_id"),
+					resource.TestCheckResourceAttrPair("gitlab_group_issue_board.foo", "lists.1.label_id", "gitlab_group_label.doing", "label_id"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_issue_board.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Swap the lists
+			{
+				Config: testAccGitlabGroupIssueBoardConfig(group.ID, "doing", "todo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("gitlab_group_issue_board.foo", "lists.0.label_id", "gitlab_group_label.doing", "label_id"),
+					resource.TestCheckResourceAttrPair("gitlab_group_issue_board.foo", "lists.1.label_id", "gitlab_group_label.todo", "label_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGitlabGroupIssueBoardConfig(group int, first, second string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group_label" "todo" {
+  group = %[1]d
+  name  = "todo"
+  color = "#ffcc00"
+}
+
+resource "gitlab_group_label" "doing" {
+  group = %[1]d
+  name  = "doing"
+  color = "#0033cc"
+}
+
+resource "gitlab_group_issue_board" "foo" {
+  group = %[1]d
+  name  = "Kanban"
+
+  lists {
+    label_id = gitlab_group_label.%[2]s.label_id
+  }
+
+  lists {
+    label_id = gitlab_group_label.%[3]s.label_id
+  }
+}`, group, first, second)
+}
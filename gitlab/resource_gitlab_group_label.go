@@ -38,6 +38,10 @@ func resourceGitlabGroupLabel() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"label_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -84,6 +88,7 @@ func resourceGitlabGroupLabelRead(d *schema.ResourceData, meta interface{}) erro
 				d.Set("description", label.Description)
 				d.Set("color", label.Color)
 				d.Set("name", label.Name)
+				d.Set("label_id", label.ID)
 				return nil
 			}
 		}
@@ -0,0 +1,80 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupMembers() *schema.Resource {
+	acceptedAccessLevels := make([]string, 0, len(accessLevelID))
+	for k := range accessLevelID {
+		acceptedAccessLevels = append(acceptedAccessLevels, k)
+	}
+	return &schema.Resource{
+		Create: resourceGitlabGroupMembersCreate,
+		Read:   resourceGitlabGroupMembersRead,
+		Update: resourceGitlabGroupMembersUpdate,
+		Delete: resourceGitlabGroupMembersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: membersSchema("group", acceptedAccessLevels),
+	}
+}
+
+func resourceGitlabGroupMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("group").(string))
+
+	return resourceGitlabGroupMembersUpdate(d, meta)
+}
+
+func resourceGitlabGroupMembersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	log.Printf("[DEBUG] read gitlab group members of %s", group)
+
+	members, err := listDirectMembers(client, groupMembersPath(group))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group %s not found so removing its members from state", group)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("group", group)
+	return setMembersState(d, members)
+}
+
+func resourceGitlabGroupMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	log.Printf("[DEBUG] update gitlab group members of %s", group)
+
+	if err := syncMembers(d, client, groupMembersPath(group)); err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupMembersRead(d, meta)
+}
+
+func resourceGitlabGroupMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab group members of %s", group)
+
+	return deleteMembers(d, client, groupMembersPath(group))
+}
+
+func groupMembersPath(group string) string {
+	return fmt.Sprintf("groups/%s/members", url.PathEscape(group))
+}
@@ -0,0 +1,150 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupMembers_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	parent := testAccCreateGroups(t, client, 1)[0]
+	group, _, err := client.Groups.CreateGroup(&gitlab.CreateGroupOptions{
+		Name:     gitlab.String(fmt.Sprintf("%s-subgroup", parent.Name)),
+		Path:     gitlab.String(fmt.Sprintf("%s-subgroup", parent.Path)),
+		ParentID: gitlab.Int(parent.ID),
+	})
+	if err != nil {
+		t.Fatalf("could not create test subgroup: %v", err)
+	}
+	users := testAccCreateUsers(t, client, 4)
+	expiresAt := time.Now().AddDate(0, 1, 0).Format("2006-01-02")
+
+	// The first user is an inherited member, which must be kept.
+	testAccAddGroupMembers(t, client, parent.ID, users[:1])
+	// The last user is added outside of Terraform and must be removed.
+	testAccAddGroupMembers(t, client, group.ID, users[3:])
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupMembersDestroy(client, group.ID, users[1:3]),
+		Steps: []resource.TestStep{
+			// Add members by user ID and by username
+			{
+				Config: testAccGitlabGroupMembersConfig(group.ID, fmt.Sprintf(`
+  members {
+    user_id      = %d
+    access_level = "developer"
+  }
+
+  members {
+    username     = %q
+    access_level = "reporter"
+    expires_at   = %q
+  }`, users[1].ID, users[2].Username, expiresAt)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_members.foo", "members.#", "2"),
+					testAccCheckGitlabGroupMembers(client, group.ID, map[int]gitlab.AccessLevelValue{
+						users[1].ID: gitlab.DeveloperPermissions,
+						users[2].ID: gitlab.ReporterPermissions,
+					}),
+					testAccCheckGitlabGroupMembersInherited(client, parent.ID, users[0]),
+				),
+			},
+			// Keep a member which is added outside of Terraform but skipped
+			{
+				PreConfig: func() {
+					testAccAddGroupMembers(t, client, group.ID, users[3:])
+				},
+				Config: testAccGitlabGroupMembersConfig(group.ID, fmt.Sprintf(`
+  skip_users = [%q]
+
+  members {
+    user_id      = %d
+    access_level = "maintainer"
+  }`, users[3].Username, users[1].ID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_members.foo", "members.#", "1"),
+					testAccCheckGitlabGroupMembers(client, group.ID, map[int]gitlab.AccessLevelValue{
+						users[1].ID: gitlab.MaintainerPermissions,
+						users[3].ID: gitlab.DeveloperPermissions,
+					}),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_group_members.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_users"},
+			},
+		},
+	})
+}
+
+// testAccCheckGitlabGroupMembers checks the direct members of the group,
+// ignoring the owners of the group.
+func testAccCheckGitlabGroupMembers(client *gitlab.Client, group int, expected map[int]gitlab.AccessLevelValue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		members, err := listDirectMembers(client, groupMembersPath(fmt.Sprintf("%d", group)))
+		if err != nil {
+			return err
+		}
+
+		got := map[int]gitlab.AccessLevelValue{}
+		for _, member := range members {
+			if member.AccessLevel != gitlab.OwnerPermission {
+				got[member.ID] = member.AccessLevel
+			}
+		}
+		if len(got) != len(expected) {
+			return fmt.Errorf("expected %d members, got %d: %v", len(expected), len(got), got)
+		}
+		for id, level := range expected {
+			if got[id] != level {
+				return fmt.Errorf("expected access level %d for user %d, got %d", level, id, got[id])
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCheckGitlabGroupMembersInherited checks that the member of the parent group was kept.
+func testAccCheckGitlabGroupMembersInherited(client *gitlab.Client, parent int, user *gitlab.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, _, err := client.GroupMembers.GetGroupMember(parent, user.ID)
+		if err != nil {
+			return fmt.Errorf("inherited member %d of parent group %d was removed: %w", user.ID, parent, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabGroupMembersDestroy(client *gitlab.Client, group int, users []*gitlab.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, user := range users {
+			_, _, err := client.GroupMembers.GetGroupMember(group, user.ID)
+			if err == nil {
+				return fmt.Errorf("user %d is still a member of group %d", user.ID, group)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabGroupMembersConfig(group int, members string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group_members" "foo" {
+  group = %d
+%s
+}`, group, members)
+}
@@ -0,0 +1,123 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupMilestone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabGroupMilestoneCreate,
+		Read:   resourceGitlabGroupMilestoneRead,
+		Update: resourceGitlabGroupMilestoneUpdate,
+		Delete: resourceGitlabGroupMilestoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: milestoneSchema("group"),
+	}
+}
+
+func resourceGitlabGroupMilestoneCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+	options, err := expandCreateMilestoneOptions(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] create gitlab milestone %q in group %s", *options.Title, group)
+
+	milestone, _, err := client.GroupMilestones.CreateGroupMilestone(group, (*gitlab.CreateGroupMilestoneOptions)(options))
+	if err != nil {
+		return err
+	}
+
+	milestoneID := strconv.Itoa(milestone.ID)
+	d.SetId(buildTwoPartID(&group, &milestoneID))
+
+	if err := closeNewMilestone(d, client, fmt.Sprintf("groups/%s/milestones/%d", url.PathEscape(group), milestone.ID)); err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupMilestoneRead(d, meta)
+}
+
+func resourceGitlabGroupMilestoneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, milestoneID, err := parseMilestoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab milestone %d in group %s", milestoneID, group)
+
+	milestone, _, err := client.GroupMilestones.GetGroupMilestone(group, milestoneID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group milestone %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("group", group)
+	setMilestoneState(d, &gitlab.Milestone{
+		ID:          milestone.ID,
+		IID:         milestone.IID,
+		Title:       milestone.Title,
+		Description: milestone.Description,
+		StartDate:   milestone.StartDate,
+		DueDate:     milestone.DueDate,
+		State:       milestone.State,
+		Expired:     milestone.Expired,
+	})
+	return nil
+}
+
+func resourceGitlabGroupMilestoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, milestoneID, err := parseMilestoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] update gitlab group milestone %s", d.Id())
+
+	if err := updateMilestone(client, fmt.Sprintf("groups/%s/milestones/%d", url.PathEscape(group), milestoneID), expandUpdateMilestoneOptions(d)); err != nil {
+		return err
+	}
+
+	return resourceGitlabGroupMilestoneRead(d, meta)
+}
+
+func resourceGitlabGroupMilestoneDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group, milestoneID, err := parseMilestoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Delete gitlab group milestone %s", d.Id())
+
+	// go-gitlab does not support deleting group milestones yet.
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("groups/%s/milestones/%d", url.PathEscape(group), milestoneID), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
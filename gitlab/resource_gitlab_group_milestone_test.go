@@ -0,0 +1,76 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupMilestone_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	group := testAccCreateGroups(t, client, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupMilestoneDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a group milestone
+			{
+				Config: testAccGitlabMilestoneConfig("group", group.ID, "2021 Q2", `
+  start_date = "2021-04-01"
+  due_date   = "2021-06-30"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "title", "2021 Q2"),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "start_date", "2021-04-01"),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "due_date", "2021-06-30"),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "state", "active"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_milestone.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Close the milestone, removing its dates
+			{
+				Config: testAccGitlabMilestoneConfig("group", group.ID, "2021 Q2", `
+  state = "closed"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "start_date", ""),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "due_date", ""),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.foo", "state", "closed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupMilestoneDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_group_milestone" {
+				continue
+			}
+
+			group, milestoneID, err := parseMilestoneID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = client.GroupMilestones.GetGroupMilestone(group, milestoneID)
+			if err == nil {
+				return fmt.Errorf("group milestone %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,168 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabGroupPushRules() *schema.Resource {
+	s := projectPushRulesSchema()
+	s["group"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceGitlabGroupPushRulesCreate,
+		Read:   resourceGitlabGroupPushRulesRead,
+		Update: resourceGitlabGroupPushRulesUpdate,
+		Delete: resourceGitlabGroupPushRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceGitlabGroupPushRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	isEE, err := isGitLabEE(client)
+	if err != nil {
+		return fmt.Errorf("Failed to check the GitLab edition: %w", err)
+	}
+	if !isEE {
+		return errors.New("Group push rules are only available in GitLab EE")
+	}
+
+	// The API responds with 404 Not Found when the group has no push rules yet.
+	_, _, err = client.Groups.GetGroupPushRules(group)
+	if err == nil {
+		return fmt.Errorf("Push rules already exist for group %q. They were created outside of Terraform "+
+			"and must be imported first", group)
+	}
+	if !is404(err) {
+		return fmt.Errorf("Failed to get push rules for group %q: %w", group, err)
+	}
+
+	log.Printf("[DEBUG] create gitlab group push rules for group %q", group)
+
+	_, _, err = client.Groups.AddGroupPushRule(group, expandAddGroupPushRuleOptions(d))
+	if err != nil {
+		return fmt.Errorf("Failed to add push rules for group %q: %w", group, err)
+	}
+
+	d.SetId(group)
+
+	return resourceGitlabGroupPushRulesRead(d, meta)
+}
+
+func resourceGitlabGroupPushRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	log.Printf("[DEBUG] read gitlab group push rules for group %q", d.Id())
+
+	pushRules, _, err := client.Groups.GetGroupPushRules(d.Id())
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group push rules for group %q not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to get push rules for group %q: %w", d.Id(), err)
+	}
+
+	d.Set("group", d.Id())
+	for key, value := range flattenGroupPushRules(pushRules) {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupPushRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	log.Printf("[DEBUG] update gitlab group push rules for group %q", d.Id())
+
+	_, _, err := client.Groups.EditGroupPushRule(d.Id(), expandEditGroupPushRuleOptions(d))
+	if err != nil {
+		return fmt.Errorf("Failed to edit push rules for group %q: %w", d.Id(), err)
+	}
+
+	return resourceGitlabGroupPushRulesRead(d, meta)
+}
+
+func resourceGitlabGroupPushRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	log.Printf("[DEBUG] Delete gitlab group push rules for group %q", d.Id())
+
+	_, err := client.Groups.DeleteGroupPushRule(d.Id())
+	if err != nil && !is404(err) {
+		return fmt.Errorf("Failed to delete push rules for group %q: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// expandAddGroupPushRuleOptions returns the options to add the configured push rules.
+// Group push rules have the same attributes as project push rules.
+func expandAddGroupPushRuleOptions(d *schema.ResourceData) *gitlab.AddGroupPushRuleOptions {
+	options := expandAddProjectPushRuleOptions(d, "")
+
+	return &gitlab.AddGroupPushRuleOptions{
+		AuthorEmailRegex:           options.AuthorEmailRegex,
+		BranchNameRegex:            options.BranchNameRegex,
+		CommitMessageRegex:         options.CommitMessageRegex,
+		CommitMessageNegativeRegex: options.CommitMessageNegativeRegex,
+		FileNameRegex:              options.FileNameRegex,
+		CommitCommitterCheck:       options.CommitCommitterCheck,
+		DenyDeleteTag:              options.DenyDeleteTag,
+		MemberCheck:                options.MemberCheck,
+		PreventSecrets:             options.PreventSecrets,
+		RejectUnsignedCommits:      options.RejectUnsignedCommits,
+		MaxFileSize:                options.MaxFileSize,
+	}
+}
+
+// expandEditGroupPushRuleOptions returns the options to edit the changed push rules.
+func expandEditGroupPushRuleOptions(d *schema.ResourceData) *gitlab.EditGroupPushRuleOptions {
+	options := expandEditProjectPushRuleOptions(d, "")
+
+	return &gitlab.EditGroupPushRuleOptions{
+		AuthorEmailRegex:           options.AuthorEmailRegex,
+		BranchNameRegex:            options.BranchNameRegex,
+		CommitMessageRegex:         options.CommitMessageRegex,
+		CommitMessageNegativeRegex: options.CommitMessageNegativeRegex,
+		FileNameRegex:              options.FileNameRegex,
+		CommitCommitterCheck:       options.CommitCommitterCheck,
+		DenyDeleteTag:              options.DenyDeleteTag,
+		MemberCheck:                options.MemberCheck,
+		PreventSecrets:             options.PreventSecrets,
+		RejectUnsignedCommits:      options.RejectUnsignedCommits,
+		MaxFileSize:                options.MaxFileSize,
+	}
+}
+
+func flattenGroupPushRules(pushRules *gitlab.GroupPushRules) map[string]interface{} {
+	return map[string]interface{}{
+		"author_email_regex":            pushRules.AuthorEmailRegex,
+		"branch_name_regex":             pushRules.BranchNameRegex,
+		"commit_message_regex":          pushRules.CommitMessageRegex,
+		"commit_message_negative_regex": pushRules.CommitMessageNegativeRegex,
+		"file_name_regex":               pushRules.FileNameRegex,
+		"commit_committer_check":        pushRules.CommitCommitterCheck,
+		"deny_delete_tag":               pushRules.DenyDeleteTag,
+		"member_check":                  pushRules.MemberCheck,
+		"prevent_secrets":               pushRules.PreventSecrets,
+		"reject_unsigned_commits":       pushRules.RejectUnsignedCommits,
+		"max_file_size":                 pushRules.MaxFileSize,
+	}
+}
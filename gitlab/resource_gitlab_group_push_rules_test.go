@@ -0,0 +1,144 @@
+package gitlab
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	. "github.com/onsi/gomega"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupPushRules_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+
+	testAccCheckEE(t, client)
+
+	group := testAccCreateGroups(t, client, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabGroupPushRulesDestroy(client, group.ID),
+		Steps: []resource.TestStep{
+			// Create push rules
+			{
+				Config: testAccGitlabGroupPushRulesConfig(group.ID, `
+author_email_regex = "foo_author"
+branch_name_regex = "foo_branch"
+commit_message_regex = "foo_commit"
+commit_message_negative_regex = "foo_not_commit"
+file_name_regex = "foo_file"
+commit_committer_check = true
+deny_delete_tag = true
+member_check = true
+prevent_secrets = true
+reject_unsigned_commits = true
+max_file_size = 123
+`),
+				Check: testAccCheckGitlabGroupPushRulesAttributes(client, group.ID, &gitlab.GroupPushRules{
+					AuthorEmailRegex:           "foo_author",
+					BranchNameRegex:            "foo_branch",
+					CommitMessageRegex:         "foo_commit",
+					CommitMessageNegativeRegex: "foo_not_commit",
+					FileNameRegex:              "foo_file",
+					CommitCommitterCheck:       true,
+					DenyDeleteTag:              true,
+					MemberCheck:                true,
+					PreventSecrets:             true,
+					RejectUnsignedCommits:      true,
+					MaxFileSize:                123,
+				}),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_push_rules.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update some push rules and remove the others
+			{
+				Config: testAccGitlabGroupPushRulesConfig(group.ID, `
+branch_name_regex = "(feature|hotfix)\\/*"
+member_check = false
+max_file_size = 1234
+`),
+				Check: testAccCheckGitlabGroupPushRulesAttributes(client, group.ID, &gitlab.GroupPushRules{
+					BranchNameRegex: `(feature|hotfix)\/*`,
+					MaxFileSize:     1234,
+				}),
+			},
+		},
+	})
+}
+
+func TestAccGitlabGroupPushRules_ce(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+
+	isEE, err := isGitLabEE(client)
+	if err != nil {
+		t.Fatalf("could not check GitLab edition: %v", err)
+	}
+	if isEE {
+		t.Skip("Test is skipped for the Enterprise version of GitLab")
+	}
+
+	group := testAccCreateGroups(t, client, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitlabGroupPushRulesConfig(group.ID, `branch_name_regex = "foo_branch"`),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("Group push rules are only available in GitLab EE")),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupPushRulesAttributes(client *gitlab.Client, gid interface{}, want *gitlab.GroupPushRules) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return InterceptGomegaFailure(func() {
+			got, _, err := client.Groups.GetGroupPushRules(gid)
+			Expect(err).To(BeNil())
+
+			Expect(got.AuthorEmailRegex).To(Equal(want.AuthorEmailRegex), "author_email_regex")
+			Expect(got.BranchNameRegex).To(Equal(want.BranchNameRegex), "branch_name_regex")
+			Expect(got.CommitMessageRegex).To(Equal(want.CommitMessageRegex), "commit_message_regex")
+			Expect(got.CommitMessageNegativeRegex).To(Equal(want.CommitMessageNegativeRegex), "commit_message_negative_regex")
+			Expect(got.FileNameRegex).To(Equal(want.FileNameRegex), "file_name_regex")
+			Expect(got.CommitCommitterCheck).To(Equal(want.CommitCommitterCheck), "commit_committer_check")
+			Expect(got.DenyDeleteTag).To(Equal(want.DenyDeleteTag), "deny_delete_tag")
+			Expect(got.MemberCheck).To(Equal(want.MemberCheck), "member_check")
+			Expect(got.PreventSecrets).To(Equal(want.PreventSecrets), "prevent_secrets")
+			Expect(got.RejectUnsignedCommits).To(Equal(want.RejectUnsignedCommits), "reject_unsigned_commits")
+			Expect(got.MaxFileSize).To(Equal(want.MaxFileSize), "max_file_size")
+		})
+	}
+}
+
+func testAccCheckGitlabGroupPushRulesDestroy(client *gitlab.Client, gid interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, _, err := client.Groups.GetGroupPushRules(gid)
+		if err == nil {
+			return fmt.Errorf("push rules of group %v still exist", gid)
+		}
+		if !is404(err) {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccGitlabGroupPushRulesConfig(group int, pushRules string) string {
+	return fmt.Sprintf(`
+resource "gitlab_group_push_rules" "foo" {
+  group = %d
+%s
+}`, group, pushRules)
+}
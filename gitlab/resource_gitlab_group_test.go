@@ -150,7 +150,9 @@ func TestAccGitlabGroup_nested(t *testing.T) {
 	var group gitlab.Group
 	var group2 gitlab.Group
 	var nestedGroup gitlab.Group
+	var nestedGroupID int
 	rInt := acctest.RandInt()
+	client := testAccNewClient(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -174,10 +176,13 @@ func TestAccGitlabGroup_nested(t *testing.T) {
 						TwoFactorGracePeriod:  48,           // default value
 						Parent:                &group,
 					}),
+					testAccCheckGitlabGroupSaveID(&nestedGroup, &nestedGroupID),
 				),
 			},
+			// Transfer the nested group to another parent
 			{
-				Config: testAccGitlabNestedGroupChangeParentConfig(rInt),
+				SkipFunc: isGitLabVersionLessThan(client, groupTransferMinimumVersion),
+				Config:   testAccGitlabNestedGroupChangeParentConfig(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGitlabGroupExists("gitlab_group.foo", &group),
 					testAccCheckGitlabGroupExists("gitlab_group.foo2", &group2),
@@ -193,10 +198,13 @@ func TestAccGitlabGroup_nested(t *testing.T) {
 						TwoFactorGracePeriod:  48,           // default value
 						Parent:                &group2,
 					}),
+					testAccCheckGitlabGroupID(&nestedGroup, &nestedGroupID),
 				),
 			},
+			// Transfer the nested group to the top level
 			{
-				Config: testAccGitlabNestedGroupRemoveParentConfig(rInt),
+				SkipFunc: isGitLabVersionLessThan(client, groupTransferMinimumVersion),
+				Config:   testAccGitlabNestedGroupRemoveParentConfig(rInt),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGitlabGroupExists("gitlab_group.foo", &group),
 					testAccCheckGitlabGroupExists("gitlab_group.foo2", &group2),
@@ -211,6 +219,7 @@ func TestAccGitlabGroup_nested(t *testing.T) {
 						SubGroupCreationLevel: "owner",      // default value
 						TwoFactorGracePeriod:  48,           // default value
 					}),
+					testAccCheckGitlabGroupID(&nestedGroup, &nestedGroupID),
 				),
 			},
 			// TODO In EE version, re-creating on the same path where a previous group was soft-deleted doesn't work.
@@ -331,6 +340,23 @@ func testAccCheckGitlabGroupExists(n string, group *gitlab.Group) resource.TestC
 	}
 }
 
+func testAccCheckGitlabGroupSaveID(group *gitlab.Group, id *int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		*id = group.ID
+		return nil
+	}
+}
+
+// testAccCheckGitlabGroupID checks that the group was updated in place, and not re-created.
+func testAccCheckGitlabGroupID(group *gitlab.Group, wantID *int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if group.ID != *wantID {
+			return fmt.Errorf("got group id %d; want %d", group.ID, *wantID)
+		}
+		return nil
+	}
+}
+
 type testAccGitlabGroupExpectedAttributes struct {
 	Name                  string
 	Path                  string
@@ -33,6 +33,10 @@ func resourceGitlabLabel() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"label_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -79,6 +83,7 @@ func resourceGitlabLabelRead(d *schema.ResourceData, meta interface{}) error {
 				d.Set("description", label.Description)
 				d.Set("color", label.Color)
 				d.Set("name", label.Name)
+				d.Set("label_id", label.ID)
 				return nil
 			}
 		}
@@ -0,0 +1,288 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// https://docs.gitlab.com/ee/api/users.html#create-a-personal-access-token
+// https://docs.gitlab.com/ee/api/users.html#create-an-impersonation-token
+
+// Personal access and impersonation tokens cannot be edited, see withTokenRotation.
+func resourceGitlabPersonalAccessToken() *schema.Resource {
+	return withTokenRotation(&schema.Resource{
+		Create: resourceGitlabPersonalAccessTokenCreate,
+		Read:   resourceGitlabPersonalAccessTokenRead,
+		Delete: resourceGitlabPersonalAccessTokenDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"api", "read_user", "read_api", "read_repository", "write_repository",
+						"read_registry", "write_registry", "sudo",
+					}, false),
+				},
+			},
+			"expires_at": {
+				Type:         schema.TypeString, // Format YYYY-MM-DD
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDateFunc,
+			},
+			"impersonation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"revoked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	})
+}
+
+// listPersonalAccessTokensOptions represents the options to list the personal access tokens of a user,
+// which are not supported by go-gitlab yet.
+type listPersonalAccessTokensOptions struct {
+	gitlab.ListOptions
+	UserID *int `url:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+var errPersonalAccessTokenNotFound = errors.New("personal access token not found")
+
+func resourceGitlabPersonalAccessTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID := d.Get("user_id").(int)
+	name := d.Get("name").(string)
+	scopes := stringSetToStringSlice(d.Get("scopes").(*schema.Set))
+
+	var expiresAt *time.Time
+	if v, ok := d.GetOk("expires_at"); ok {
+		parsedExpiresAt, err := time.Parse("2006-01-02", v.(string))
+		if err != nil {
+			return fmt.Errorf("Invalid expires_at date: %v", err)
+		}
+		expiresAt = &parsedExpiresAt
+	}
+
+	var tokenID int
+	var token string
+
+	if d.Get("impersonation").(bool) {
+		log.Printf("[DEBUG] create gitlab impersonation token %q for user %d", name, userID)
+
+		impersonationToken, _, err := client.Users.CreateImpersonationToken(userID, &gitlab.CreateImpersonationTokenOptions{
+			Name:      gitlab.String(name),
+			Scopes:    scopes,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			return err
+		}
+		tokenID, token = impersonationToken.ID, impersonationToken.Token
+	} else {
+		log.Printf("[DEBUG] create gitlab personal access token %q for user %d", name, userID)
+
+		options := &gitlab.CreatePersonalAccessTokenOptions{
+			Name:   gitlab.String(name),
+			Scopes: *scopes,
+		}
+		if expiresAt != nil {
+			isoExpiresAt := gitlab.ISOTime(*expiresAt)
+			options.ExpiresAt = &isoExpiresAt
+		}
+
+		personalAccessToken, _, err := client.Users.CreatePersonalAccessToken(userID, options)
+		if err != nil {
+			return err
+		}
+		tokenID, token = personalAccessToken.ID, personalAccessToken.Token
+	}
+
+	userIDString := strconv.Itoa(userID)
+	tokenIDString := strconv.Itoa(tokenID)
+	d.SetId(buildTwoPartID(&userIDString, &tokenIDString))
+
+	// The token is only available on creation
+	d.Set("token", token)
+
+	return resourceGitlabPersonalAccessTokenRead(d, meta)
+}
+
+func resourceGitlabPersonalAccessTokenRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, tokenID, err := parsePersonalAccessTokenID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab personal access token %d of user %d", tokenID, userID)
+
+	// The impersonation tokens endpoint only returns impersonation tokens,
+	// so a token which is not found there is a personal access token.
+	impersonation := true
+	token, _, err := client.Users.GetImpersonationToken(userID, tokenID)
+	if is404(err) {
+		impersonation = false
+		token, err = getPersonalAccessToken(client, userID, tokenID)
+	}
+	if err != nil {
+		if is404(err) || errors.Is(err, errPersonalAccessTokenNotFound) {
+			log.Printf("[DEBUG] gitlab personal access token %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	if token.Revoked {
+		log.Printf("[DEBUG] gitlab personal access token %s is revoked so removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_id", userID)
+	d.Set("impersonation", impersonation)
+	d.Set("name", token.Name)
+	if err := d.Set("scopes", token.Scopes); err != nil {
+		return err
+	}
+	if token.ExpiresAt != nil {
+		d.Set("expires_at", token.ExpiresAt.String())
+	} else {
+		d.Set("expires_at", "")
+	}
+	setTokenRotationState(d)
+	d.Set("active", token.Active)
+	d.Set("revoked", token.Revoked)
+	if token.CreatedAt != nil {
+		d.Set("created_at", token.CreatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceGitlabPersonalAccessTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, tokenID, err := parsePersonalAccessTokenID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Revoke gitlab personal access token %d of user %d", tokenID, userID)
+
+	if d.Get("impersonation").(bool) {
+		_, err = client.Users.RevokeImpersonationToken(userID, tokenID)
+	} else {
+		err = revokePersonalAccessToken(client, tokenID)
+	}
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+// getPersonalAccessToken looks the token up in the list of personal access tokens of the user,
+// because older GitLab versions have no endpoint to get a single personal access token.
+// The token is returned as an impersonation token, which has the same attributes.
+func getPersonalAccessToken(client *gitlab.Client, userID, tokenID int) (*gitlab.ImpersonationToken, error) {
+	options := &listPersonalAccessTokensOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		UserID: gitlab.Int(userID),
+	}
+
+	for options.Page != 0 {
+		req, err := client.NewRequest(http.MethodGet, "personal_access_tokens", options, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var tokens []*gitlab.ImpersonationToken
+		resp, err := client.Do(req, &tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, token := range tokens {
+			if token.ID == tokenID {
+				return token, nil
+			}
+		}
+
+		options.Page = resp.NextPage
+	}
+
+	return nil, errPersonalAccessTokenNotFound
+}
+
+func revokePersonalAccessToken(client *gitlab.Client, tokenID int) error {
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("personal_access_tokens/%d", tokenID), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+func parsePersonalAccessTokenID(id string) (int, int, error) {
+	rawUserID, rawTokenID, err := parseTwoPartID(id)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	userID, err := strconv.Atoi(rawUserID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Unexpected user ID %q in ID %q: %w", rawUserID, id, err)
+	}
+
+	tokenID, err := strconv.Atoi(rawTokenID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Unexpected token ID %q in ID %q: %w", rawTokenID, id, err)
+	}
+
+	return userID, tokenID, nil
+}
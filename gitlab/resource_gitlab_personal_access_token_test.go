@@ -0,0 +1,99 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabPersonalAccessToken_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	user := testAccCreateUsers(t, client, 1)[0]
+	expiresAt := time.Now().AddDate(0, 1, 0).Format("2006-01-02")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabPersonalAccessTokenDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a personal access token and an impersonation token
+			{
+				Config: testAccGitlabPersonalAccessTokenConfig(user.ID, expiresAt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_personal_access_token.foo", "token"),
+					resource.TestCheckResourceAttr("gitlab_personal_access_token.foo", "expires_at", expiresAt),
+					resource.TestCheckResourceAttr("gitlab_personal_access_token.foo", "active", "true"),
+					resource.TestCheckResourceAttr("gitlab_personal_access_token.foo", "impersonation", "false"),
+					resource.TestCheckResourceAttrSet("gitlab_personal_access_token.bar", "token"),
+					resource.TestCheckResourceAttr("gitlab_personal_access_token.bar", "expires_at", ""),
+					resource.TestCheckResourceAttr("gitlab_personal_access_token.bar", "impersonation", "true"),
+				),
+			},
+			// Verify import, the token is only available on creation
+			{
+				ResourceName:            "gitlab_personal_access_token.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			{
+				ResourceName:            "gitlab_personal_access_token.bar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabPersonalAccessTokenDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_personal_access_token" {
+				continue
+			}
+
+			userID, tokenID, err := parsePersonalAccessTokenID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			var token *gitlab.ImpersonationToken
+			if rs.Primary.Attributes["impersonation"] == "true" {
+				token, _, err = client.Users.GetImpersonationToken(userID, tokenID)
+			} else {
+				token, err = getPersonalAccessToken(client, userID, tokenID)
+			}
+			if err == nil && !token.Revoked {
+				return fmt.Errorf("personal access token %s is not revoked", rs.Primary.ID)
+			}
+			if err != nil && !is404(err) && !errors.Is(err, errPersonalAccessTokenNotFound) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabPersonalAccessTokenConfig(userID int, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "gitlab_personal_access_token" "foo" {
+  user_id    = %[1]d
+  name       = "my-token"
+  scopes     = ["read_api", "read_repository"]
+  expires_at = %[2]q
+}
+
+resource "gitlab_personal_access_token" "bar" {
+  user_id       = %[1]d
+  name          = "my-impersonation-token"
+  scopes        = ["api"]
+  impersonation = true
+}`, userID, expiresAt)
+}
@@ -173,53 +173,7 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Optional: true,
 		Computed: true,
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"author_email_regex": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"branch_name_regex": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"commit_message_regex": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"commit_message_negative_regex": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"file_name_regex": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"commit_committer_check": {
-					Type:     schema.TypeBool,
-					Optional: true,
-				},
-				"deny_delete_tag": {
-					Type:     schema.TypeBool,
-					Optional: true,
-				},
-				"member_check": {
-					Type:     schema.TypeBool,
-					Optional: true,
-				},
-				"prevent_secrets": {
-					Type:     schema.TypeBool,
-					Optional: true,
-				},
-				"reject_unsigned_commits": {
-					Type:     schema.TypeBool,
-					Optional: true,
-				},
-				"max_file_size": {
-					Type:         schema.TypeInt,
-					Optional:     true,
-					ValidateFunc: validation.IntAtLeast(0),
-				},
-			},
+			Schema: projectPushRulesSchema(),
 		},
 	},
 	"template_name": {
@@ -284,6 +238,58 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 	},
 }
 
+// projectPushRulesSchema returns the schema of the push rules attributes, shared by
+// the push_rules block of gitlab_project and the gitlab_project_push_rules resource.
+func projectPushRulesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"author_email_regex": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"branch_name_regex": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"commit_message_regex": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"commit_message_negative_regex": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"file_name_regex": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"commit_committer_check": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"deny_delete_tag": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"member_check": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"prevent_secrets": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"reject_unsigned_commits": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"max_file_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+}
+
 func resourceGitlabProject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceGitlabProjectCreate,
@@ -815,10 +821,12 @@ func resourceGitlabProjectDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// editOrAddPushRules sets the push rules of the push_rules block. Since the block is computed,
+// it cannot detect push rules managed by a gitlab_project_push_rules resource and overwrites them.
 func editOrAddPushRules(client *gitlab.Client, projectID string, d *schema.ResourceData) error {
 	log.Printf("[DEBUG] Editing push rules for project %q", projectID)
 
-	editOptions := expandEditProjectPushRuleOptions(d)
+	editOptions := expandEditProjectPushRuleOptions(d, "push_rules.0.")
 	_, _, err := client.Projects.EditProjectPushRule(projectID, editOptions)
 	if err == nil {
 		return nil
@@ -834,7 +842,7 @@ func editOrAddPushRules(client *gitlab.Client, projectID string, d *schema.Resou
 	log.Printf("[DEBUG] Failed to edit push rules for project %q: %v", projectID, err)
 	log.Printf("[DEBUG] Creating new push rules for project %q", projectID)
 
-	addOptions := expandAddProjectPushRuleOptions(d)
+	addOptions := expandAddProjectPushRuleOptions(d, "push_rules.0.")
 	_, _, err = client.Projects.AddProjectPushRule(projectID, addOptions)
 	if err != nil {
 		return err
@@ -843,100 +851,104 @@ func editOrAddPushRules(client *gitlab.Client, projectID string, d *schema.Resou
 	return nil
 }
 
-func expandEditProjectPushRuleOptions(d *schema.ResourceData) *gitlab.EditProjectPushRuleOptions {
+// expandEditProjectPushRuleOptions returns the options to edit the changed push rules,
+// whose attributes are read from the keys starting with the given prefix.
+func expandEditProjectPushRuleOptions(d *schema.ResourceData, prefix string) *gitlab.EditProjectPushRuleOptions {
 	options := &gitlab.EditProjectPushRuleOptions{}
 
-	if d.HasChange("push_rules.0.author_email_regex") {
-		options.AuthorEmailRegex = gitlab.String(d.Get("push_rules.0.author_email_regex").(string))
+	if d.HasChange(prefix + "author_email_regex") {
+		options.AuthorEmailRegex = gitlab.String(d.Get(prefix + "author_email_regex").(string))
 	}
 
-	if d.HasChange("push_rules.0.branch_name_regex") {
-		options.BranchNameRegex = gitlab.String(d.Get("push_rules.0.branch_name_regex").(string))
+	if d.HasChange(prefix + "branch_name_regex") {
+		options.BranchNameRegex = gitlab.String(d.Get(prefix + "branch_name_regex").(string))
 	}
 
-	if d.HasChange("push_rules.0.commit_message_regex") {
-		options.CommitMessageRegex = gitlab.String(d.Get("push_rules.0.commit_message_regex").(string))
+	if d.HasChange(prefix + "commit_message_regex") {
+		options.CommitMessageRegex = gitlab.String(d.Get(prefix + "commit_message_regex").(string))
 	}
 
-	if d.HasChange("push_rules.0.commit_message_negative_regex") {
-		options.CommitMessageNegativeRegex = gitlab.String(d.Get("push_rules.0.commit_message_negative_regex").(string))
+	if d.HasChange(prefix + "commit_message_negative_regex") {
+		options.CommitMessageNegativeRegex = gitlab.String(d.Get(prefix + "commit_message_negative_regex").(string))
 	}
 
-	if d.HasChange("push_rules.0.file_name_regex") {
-		options.FileNameRegex = gitlab.String(d.Get("push_rules.0.file_name_regex").(string))
+	if d.HasChange(prefix + "file_name_regex") {
+		options.FileNameRegex = gitlab.String(d.Get(prefix + "file_name_regex").(string))
 	}
 
-	if d.HasChange("push_rules.0.commit_committer_check") {
-		options.CommitCommitterCheck = gitlab.Bool(d.Get("push_rules.0.commit_committer_check").(bool))
+	if d.HasChange(prefix + "commit_committer_check") {
+		options.CommitCommitterCheck = gitlab.Bool(d.Get(prefix + "commit_committer_check").(bool))
 	}
 
-	if d.HasChange("push_rules.0.deny_delete_tag") {
-		options.DenyDeleteTag = gitlab.Bool(d.Get("push_rules.0.deny_delete_tag").(bool))
+	if d.HasChange(prefix + "deny_delete_tag") {
+		options.DenyDeleteTag = gitlab.Bool(d.Get(prefix + "deny_delete_tag").(bool))
 	}
 
-	if d.HasChange("push_rules.0.member_check") {
-		options.MemberCheck = gitlab.Bool(d.Get("push_rules.0.member_check").(bool))
+	if d.HasChange(prefix + "member_check") {
+		options.MemberCheck = gitlab.Bool(d.Get(prefix + "member_check").(bool))
 	}
 
-	if d.HasChange("push_rules.0.prevent_secrets") {
-		options.PreventSecrets = gitlab.Bool(d.Get("push_rules.0.prevent_secrets").(bool))
+	if d.HasChange(prefix + "prevent_secrets") {
+		options.PreventSecrets = gitlab.Bool(d.Get(prefix + "prevent_secrets").(bool))
 	}
 
-	if d.HasChange("push_rules.0.reject_unsigned_commits") {
-		options.RejectUnsignedCommits = gitlab.Bool(d.Get("push_rules.0.reject_unsigned_commits").(bool))
+	if d.HasChange(prefix + "reject_unsigned_commits") {
+		options.RejectUnsignedCommits = gitlab.Bool(d.Get(prefix + "reject_unsigned_commits").(bool))
 	}
 
-	if d.HasChange("push_rules.0.max_file_size") {
-		options.MaxFileSize = gitlab.Int(d.Get("push_rules.0.max_file_size").(int))
+	if d.HasChange(prefix + "max_file_size") {
+		options.MaxFileSize = gitlab.Int(d.Get(prefix + "max_file_size").(int))
 	}
 
 	return options
 }
 
-func expandAddProjectPushRuleOptions(d *schema.ResourceData) *gitlab.AddProjectPushRuleOptions {
+// expandAddProjectPushRuleOptions returns the options to add the configured push rules,
+// whose attributes are read from the keys starting with the given prefix.
+func expandAddProjectPushRuleOptions(d *schema.ResourceData, prefix string) *gitlab.AddProjectPushRuleOptions {
 	options := &gitlab.AddProjectPushRuleOptions{}
 
-	if v, ok := d.GetOk("push_rules.0.author_email_regex"); ok {
+	if v, ok := d.GetOk(prefix + "author_email_regex"); ok {
 		options.AuthorEmailRegex = gitlab.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.branch_name_regex"); ok {
+	if v, ok := d.GetOk(prefix + "branch_name_regex"); ok {
 		options.BranchNameRegex = gitlab.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.commit_message_regex"); ok {
+	if v, ok := d.GetOk(prefix + "commit_message_regex"); ok {
 		options.CommitMessageRegex = gitlab.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.commit_message_negative_regex"); ok {
+	if v, ok := d.GetOk(prefix + "commit_message_negative_regex"); ok {
 		options.CommitMessageNegativeRegex = gitlab.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.file_name_regex"); ok {
+	if v, ok := d.GetOk(prefix + "file_name_regex"); ok {
 		options.FileNameRegex = gitlab.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.commit_committer_check"); ok {
+	if v, ok := d.GetOk(prefix + "commit_committer_check"); ok {
 		options.CommitCommitterCheck = gitlab.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.deny_delete_tag"); ok {
+	if v, ok := d.GetOk(prefix + "deny_delete_tag"); ok {
 		options.DenyDeleteTag = gitlab.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.member_check"); ok {
+	if v, ok := d.GetOk(prefix + "member_check"); ok {
 		options.MemberCheck = gitlab.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.prevent_secrets"); ok {
+	if v, ok := d.GetOk(prefix + "prevent_secrets"); ok {
 		options.PreventSecrets = gitlab.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.reject_unsigned_commits"); ok {
+	if v, ok := d.GetOk(prefix + "reject_unsigned_commits"); ok {
 		options.RejectUnsignedCommits = gitlab.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("push_rules.0.max_file_size"); ok {
+	if v, ok := d.GetOk(prefix + "max_file_size"); ok {
 		options.MaxFileSize = gitlab.Int(v.(int))
 	}
 
@@ -0,0 +1,278 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// https://docs.gitlab.com/ee/api/resource_access_tokens.html
+
+func resourceGitlabProjectAccessToken() *schema.Resource {
+	return withTokenRotation(&schema.Resource{
+		Create: resourceGitlabProjectAccessTokenCreate,
+		Read:   resourceGitlabProjectAccessTokenRead,
+		Delete: resourceGitlabProjectAccessTokenDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: accessTokenSchema("project"),
+	})
+}
+
+// accessTokenSchema returns the schema of a project or group access token,
+// which belongs to the project or group given by the parent attribute.
+func accessTokenSchema(parent string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		parent: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"scopes": {
+			Type:     schema.TypeSet,
+			Required: true,
+			ForceNew: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					"api", "read_api", "read_registry", "write_registry", "read_repository", "write_repository",
+				}, false),
+			},
+		},
+		"access_level": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "maintainer",
+			ValidateFunc: validateValueFunc([]string{"guest", "reporter", "developer", "maintainer", "owner"}),
+		},
+		"expires_at": {
+			Type:         schema.TypeString, // Format YYYY-MM-DD
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validateDateFunc,
+		},
+		"token": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"user_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"active": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"revoked": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// accessToken extends gitlab.ProjectAccessToken with the attributes not supported by go-gitlab yet.
+// Group access tokens have the same attributes as project access tokens.
+type accessToken struct {
+	gitlab.ProjectAccessToken
+	AccessLevel gitlab.AccessLevelValue `json:"access_level"`
+}
+
+// accessTokenOptions extends gitlab.CreateProjectAccessTokenOptions with the attributes not supported by go-gitlab yet.
+type accessTokenOptions struct {
+	gitlab.CreateProjectAccessTokenOptions
+	AccessLevel *gitlab.AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
+}
+
+var errAccessTokenNotFound = errors.New("access token not found")
+
+func resourceGitlabProjectAccessTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	options, err := expandAccessTokenOptions(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] create gitlab project access token %q in project %s", *options.Name, project)
+
+	token, err := createAccessToken(client, fmt.Sprintf("projects/%s/access_tokens", url.PathEscape(project)), options)
+	if err != nil {
+		return err
+	}
+
+	tokenID := strconv.Itoa(token.ID)
+	d.SetId(buildTwoPartID(&project, &tokenID))
+
+	// The token is only available on creation
+	d.Set("token", token.Token)
+
+	return resourceGitlabProjectAccessTokenRead(d, meta)
+}
+
+func resourceGitlabProjectAccessTokenRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, tokenID, err := parseAccessTokenID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab project access token %d in project %s", tokenID, project)
+
+	token, err := getAccessToken(client, fmt.Sprintf("projects/%s/access_tokens", url.PathEscape(project)), tokenID)
+	if err != nil {
+		if is404(err) || errors.Is(err, errAccessTokenNotFound) {
+			log.Printf("[DEBUG] gitlab project access token %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	return setAccessTokenState(d, token)
+}
+
+func resourceGitlabProjectAccessTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, tokenID, err := parseAccessTokenID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Delete gitlab project access token %d in project %s", tokenID, project)
+
+	_, err = client.ProjectAccessTokens.DeleteProjectAccessToken(project, tokenID)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+func expandAccessTokenOptions(d *schema.ResourceData) (*accessTokenOptions, error) {
+	options := &accessTokenOptions{
+		CreateProjectAccessTokenOptions: gitlab.CreateProjectAccessTokenOptions{
+			Name:   gitlab.String(d.Get("name").(string)),
+			Scopes: *stringSetToStringSlice(d.Get("scopes").(*schema.Set)),
+		},
+		AccessLevel: gitlab.AccessLevel(accessLevelID[d.Get("access_level").(string)]),
+	}
+
+	if v, ok := d.GetOk("expires_at"); ok {
+		expiresAt, err := time.Parse("2006-01-02", v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid expires_at date: %v", err)
+		}
+		isoExpiresAt := gitlab.ISOTime(expiresAt)
+		options.ExpiresAt = &isoExpiresAt
+	}
+
+	return options, nil
+}
+
+func createAccessToken(client *gitlab.Client, path string, options *accessTokenOptions) (*accessToken, error) {
+	req, err := client.NewRequest(http.MethodPost, path, options, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	token := new(accessToken)
+	if _, err := client.Do(req, token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// getAccessToken looks the token up in the list of access tokens, because older GitLab versions
+// have no endpoint to get a single access token. Revoked tokens are reported as not found.
+func getAccessToken(client *gitlab.Client, path string, tokenID int) (*accessToken, error) {
+	options := &gitlab.ListOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	for options.Page != 0 {
+		req, err := client.NewRequest(http.MethodGet, path, options, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var tokens []*accessToken
+		resp, err := client.Do(req, &tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, token := range tokens {
+			if token.ID == tokenID && !token.Revoked {
+				return token, nil
+			}
+		}
+
+		options.Page = resp.NextPage
+	}
+
+	return nil, errAccessTokenNotFound
+}
+
+// setAccessTokenState sets the attributes of a project or group access token,
+// except for the token itself, which is only returned on creation.
+func setAccessTokenState(d *schema.ResourceData, token *accessToken) error {
+	d.Set("name", token.Name)
+	if err := d.Set("scopes", token.Scopes); err != nil {
+		return err
+	}
+	// The access level is not returned by GitLab versions older than 14.8.
+	if token.AccessLevel != 0 {
+		d.Set("access_level", accessLevel[token.AccessLevel])
+	}
+	if token.ExpiresAt != nil {
+		d.Set("expires_at", token.ExpiresAt.String())
+	} else {
+		d.Set("expires_at", "")
+	}
+	setTokenRotationState(d)
+	d.Set("user_id", token.UserID)
+	d.Set("active", token.Active)
+	d.Set("revoked", token.Revoked)
+	if token.CreatedAt != nil {
+		d.Set("created_at", token.CreatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func parseAccessTokenID(id string) (string, int, error) {
+	parent, rawTokenID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	tokenID, err := strconv.Atoi(rawTokenID)
+	if err != nil {
+		return "", 0, fmt.Errorf("Unexpected token ID %q in ID %q: %w", rawTokenID, id, err)
+	}
+
+	return parent, tokenID, nil
+}
@@ -0,0 +1,177 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectAccessToken_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+	expiresAt := time.Now().AddDate(0, 1, 0).Format("2006-01-02")
+	newExpiresAt := time.Now().AddDate(0, 2, 0).Format("2006-01-02")
+	rotatedExpiresAt := time.Now().AddDate(0, 6, 0).Format("2006-01-02")
+	var tokenID string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabAccessTokenDestroy(client, "gitlab_project_access_token"),
+		Steps: []resource.TestStep{
+			// Create a project access token
+			{
+				Config: testAccGitlabProjectAccessTokenConfig(project.ID, expiresAt, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_project_access_token.foo", "token"),
+					resource.TestCheckResourceAttrSet("gitlab_project_access_token.foo", "user_id"),
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "access_level", "developer"),
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "expires_at", expiresAt),
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "active", "true"),
+					testAccCheckGitlabAccessTokenSaveID("gitlab_project_access_token.foo", &tokenID),
+				),
+			},
+			// Verify import, the token is only available on creation
+			{
+				ResourceName:            "gitlab_project_access_token.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Changing the expiry date creates a new token
+			{
+				Config: testAccGitlabProjectAccessTokenConfig(project.ID, newExpiresAt, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "expires_at", newExpiresAt),
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "ready_for_rotation", "false"),
+					testAccCheckGitlabAccessTokenIDChanged("gitlab_project_access_token.foo", &tokenID),
+					testAccCheckGitlabAccessTokenSaveID("gitlab_project_access_token.foo", &tokenID),
+				),
+			},
+			// A token which expires within rotate_before_days is ready for rotation, which plans a new token
+			{
+				Config: testAccGitlabProjectAccessTokenConfig(project.ID, newExpiresAt, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "ready_for_rotation", "true"),
+					testAccCheckGitlabAccessTokenIDUnchanged("gitlab_project_access_token.foo", &tokenID),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// Disabling the rotation keeps the token
+			{
+				Config: testAccGitlabProjectAccessTokenConfig(project.ID, newExpiresAt, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "ready_for_rotation", "false"),
+					testAccCheckGitlabAccessTokenIDUnchanged("gitlab_project_access_token.foo", &tokenID),
+				),
+			},
+			// Rotate the token with a moved expiry date, so that the new token is not ready for rotation
+			{
+				Config: testAccGitlabProjectAccessTokenConfig(project.ID, rotatedExpiresAt, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "expires_at", rotatedExpiresAt),
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "ready_for_rotation", "false"),
+					testAccCheckGitlabAccessTokenIDChanged("gitlab_project_access_token.foo", &tokenID),
+				),
+			},
+			// The second apply has no changes
+			{
+				Config:   testAccGitlabProjectAccessTokenConfig(project.ID, rotatedExpiresAt, 90),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabAccessTokenSaveID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckGitlabAccessTokenIDChanged(n string, oldID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == *oldID {
+			return fmt.Errorf("expected a new access token, but the ID is still %q", *oldID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabAccessTokenIDUnchanged(n string, oldID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID != *oldID {
+			return fmt.Errorf("expected access token %q to be kept, but got a new one %q", *oldID, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// testAccCheckGitlabAccessTokenDestroy checks that the project or group access tokens of the given resource type were revoked.
+func testAccCheckGitlabAccessTokenDestroy(client *gitlab.Client, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			parent, tokenID, err := parseAccessTokenID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			path := fmt.Sprintf("projects/%s/access_tokens", url.PathEscape(parent))
+			if resourceType == "gitlab_group_access_token" {
+				path = fmt.Sprintf("groups/%s/access_tokens", url.PathEscape(parent))
+			}
+
+			_, err = getAccessToken(client, path, tokenID)
+			if err == nil {
+				return fmt.Errorf("access token %s still exists", rs.Primary.ID)
+			}
+			if !errors.Is(err, errAccessTokenNotFound) && !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabProjectAccessTokenConfig(project int, expiresAt string, rotateBeforeDays int) string {
+	return fmt.Sprintf(`
+resource "gitlab_project_access_token" "foo" {
+  project            = %d
+  name               = "my-token"
+  scopes             = ["read_repository", "read_api"]
+  access_level       = "developer"
+  expires_at         = %q
+  rotate_before_days = %d
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}`, project, expiresAt, rotateBeforeDays)
+}
@@ -0,0 +1,162 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabProjectEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabProjectEnvironmentCreate,
+		Read:   resourceGitlabProjectEnvironmentRead,
+		Update: resourceGitlabProjectEnvironmentUpdate,
+		Delete: resourceGitlabProjectEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"external_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURLFunc,
+			},
+			"slug": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitlabProjectEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	options := &gitlab.CreateEnvironmentOptions{
+		Name: gitlab.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("external_url"); ok {
+		options.ExternalURL = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab environment %q in project %s", *options.Name, project)
+
+	environment, _, err := client.Environments.CreateEnvironment(project, options)
+	if err != nil {
+		return err
+	}
+
+	environmentID := strconv.Itoa(environment.ID)
+	d.SetId(buildTwoPartID(&project, &environmentID))
+
+	return resourceGitlabProjectEnvironmentRead(d, meta)
+}
+
+func resourceGitlabProjectEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, environmentID, err := parseProjectEnvironmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab environment %d in project %s", environmentID, project)
+
+	environment, _, err := client.Environments.GetEnvironment(project, environmentID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab environment %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	d.Set("name", environment.Name)
+	d.Set("external_url", environment.ExternalURL)
+	d.Set("slug", environment.Slug)
+	d.Set("state", environment.State)
+	return nil
+}
+
+func resourceGitlabProjectEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, environmentID, err := parseProjectEnvironmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	options := &gitlab.EditEnvironmentOptions{}
+
+	if d.HasChange("external_url") {
+		options.ExternalURL = gitlab.String(d.Get("external_url").(string))
+	}
+
+	log.Printf("[DEBUG] update gitlab environment %s", d.Id())
+
+	_, _, err = client.Environments.EditEnvironment(project, environmentID, options)
+	if err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectEnvironmentRead(d, meta)
+}
+
+func resourceGitlabProjectEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, environmentID, err := parseProjectEnvironmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Only stopped environments can be deleted.
+	if d.Get("state").(string) != "stopped" {
+		log.Printf("[DEBUG] Stop gitlab environment %s", d.Id())
+
+		if _, err := client.Environments.StopEnvironment(project, environmentID); err != nil && !is404(err) {
+			return fmt.Errorf("Failed to stop environment %s before deleting it: %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Delete gitlab environment %s", d.Id())
+
+	_, err = client.Environments.DeleteEnvironment(project, environmentID)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+func parseProjectEnvironmentID(id string) (string, int, error) {
+	project, rawEnvironmentID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	environmentID, err := strconv.Atoi(rawEnvironmentID)
+	if err != nil {
+		return "", 0, fmt.Errorf("Unexpected environment ID %q in ID %q: %w", rawEnvironmentID, id, err)
+	}
+
+	return project, environmentID, nil
+}
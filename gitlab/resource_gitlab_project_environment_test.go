@@ -0,0 +1,78 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectEnvironment_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectEnvironmentDestroy(client),
+		Steps: []resource.TestStep{
+			// Create an environment
+			{
+				Config: testAccGitlabProjectEnvironmentConfig(project.ID, "https://staging.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_environment.foo", "name", "staging"),
+					resource.TestCheckResourceAttr("gitlab_project_environment.foo", "external_url", "https://staging.example.com"),
+					resource.TestCheckResourceAttr("gitlab_project_environment.foo", "state", "available"),
+					resource.TestCheckResourceAttrSet("gitlab_project_environment.foo", "slug"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_environment.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the external URL
+			{
+				Config: testAccGitlabProjectEnvironmentConfig(project.ID, "https://staging.example.org"),
+				Check:  resource.TestCheckResourceAttr("gitlab_project_environment.foo", "external_url", "https://staging.example.org"),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectEnvironmentDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_project_environment" {
+				continue
+			}
+
+			project, environmentID, err := parseProjectEnvironmentID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = client.Environments.GetEnvironment(project, environmentID)
+			if err == nil {
+				return fmt.Errorf("environment %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabProjectEnvironmentConfig(project int, externalURL string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project_environment" "foo" {
+  project      = %d
+  name         = "staging"
+  external_url = %q
+}`, project, externalURL)
+}
@@ -0,0 +1,359 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// projectIntegrationEvents are the event flags shared by all integrations.
+// GitLab ignores the flags an integration does not support.
+var projectIntegrationEvents = []string{
+	"push_events",
+	"issues_events",
+	"confidential_issues_events",
+	"merge_requests_events",
+	"tag_push_events",
+	"note_events",
+	"confidential_note_events",
+	"pipeline_events",
+	"wiki_page_events",
+	"job_events",
+	"deployment_events",
+}
+
+func resourceGitlabProjectIntegration() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"project": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"slug": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"active": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"properties": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"sensitive_properties": {
+			Type:      schema.TypeMap,
+			Optional:  true,
+			Sensitive: true,
+			Elem:      &schema.Schema{Type: schema.TypeString},
+		},
+		"title": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for _, event := range projectIntegrationEvents {
+		s[event] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		Create: resourceGitlabProjectIntegrationCreate,
+		Read:   resourceGitlabProjectIntegrationRead,
+		Update: resourceGitlabProjectIntegrationUpdate,
+		Delete: resourceGitlabProjectIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGitlabProjectIntegrationImportState,
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceGitlabProjectIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+	project := d.Get("project").(string)
+	slug := d.Get("slug").(string)
+
+	d.SetId(buildTwoPartID(&project, &slug))
+
+	if err := setProjectIntegration(d, meta.(*gitlab.Client)); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return readProjectIntegrationAfterSet(d, meta)
+}
+
+func resourceGitlabProjectIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, slug, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab %s integration for project %s", slug, project)
+
+	integration, err := getProjectIntegration(client, project, slug)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab %s integration for project %s not found so removing from state", slug, project)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	d.Set("slug", slug)
+	d.Set("active", integration.Active)
+	d.Set("title", integration.Title)
+
+	// Deactivating an integration resets its settings,
+	// so there is nothing to compare with the configuration.
+	if !integration.Active {
+		return nil
+	}
+
+	for event, value := range map[string]bool{
+		"push_events":                integration.PushEvents,
+		"issues_events":              integration.IssuesEvents,
+		"confidential_issues_events": integration.ConfidentialIssuesEvents,
+		"merge_requests_events":      integration.MergeRequestsEvents,
+		"tag_push_events":            integration.TagPushEvents,
+		"note_events":                integration.NoteEvents,
+		"confidential_note_events":   integration.ConfidentialNoteEvents,
+		"pipeline_events":            integration.PipelineEvents,
+		"wiki_page_events":           integration.WikiPageEvents,
+		"job_events":                 integration.JobEvents,
+		"deployment_events":          integration.DeploymentEvents,
+	} {
+		d.Set(event, value)
+	}
+
+	properties, missing := readProjectIntegrationProperties(d.Get("properties").(map[string]interface{}), integration.Properties)
+	for _, key := range missing {
+		log.Printf("[WARN] gitlab %s integration for project %s does not return property %s, so it is removed from the state", slug, project, key)
+	}
+
+	return d.Set("properties", properties)
+}
+
+// readProjectIntegrationAfterSet reads the integration after it is set, and fails for the configured properties
+// which GitLab does not return. They are either secret, so they must not be kept in properties, or mistyped.
+func readProjectIntegrationAfterSet(d *schema.ResourceData, meta interface{}) error {
+	configured := d.Get("properties").(map[string]interface{})
+
+	if err := resourceGitlabProjectIntegrationRead(d, meta); err != nil {
+		return err
+	}
+
+	properties := d.Get("properties").(map[string]interface{})
+	var missing []string
+	for key := range configured {
+		if _, ok := properties[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+
+	return fmt.Errorf("gitlab %s integration does not return the properties %s: set secret properties in sensitive_properties "+
+		"instead, and check other properties for typos", d.Get("slug").(string), strings.Join(missing, ", "))
+}
+
+// resourceGitlabProjectIntegrationImportState imports all properties which are set in GitLab,
+// as there is no configuration yet to tell which of them are managed.
+func resourceGitlabProjectIntegrationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*gitlab.Client)
+	project, slug, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	integration, err := getProjectIntegration(client, project, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := make(map[string]interface{})
+	for key, value := range flattenProjectIntegrationProperties(integration.Properties) {
+		if value != "" && !isMaskedProjectIntegrationProperty(value.(string)) {
+			properties[key] = value
+		}
+	}
+	if err := d.Set("properties", properties); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceGitlabProjectIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := setProjectIntegration(d, meta.(*gitlab.Client)); err != nil {
+		return err
+	}
+
+	return readProjectIntegrationAfterSet(d, meta)
+}
+
+func resourceGitlabProjectIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, slug, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] delete gitlab %s integration for project %s", slug, project)
+
+	return deleteProjectIntegration(client, project, slug)
+}
+
+// projectIntegration represents any integration of a project.
+// Its properties differ for each integration.
+type projectIntegration struct {
+	gitlab.Service
+	Properties map[string]interface{} `json:"properties"`
+}
+
+func projectIntegrationPath(project, slug string) string {
+	return fmt.Sprintf("projects/%s/services/%s", url.PathEscape(project), url.PathEscape(slug))
+}
+
+func getProjectIntegration(client *gitlab.Client, project, slug string) (*projectIntegration, error) {
+	req, err := client.NewRequest(http.MethodGet, projectIntegrationPath(project, slug), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	integration := new(projectIntegration)
+	if _, err := client.Do(req, integration); err != nil {
+		return nil, err
+	}
+
+	return integration, nil
+}
+
+// setProjectIntegration configures and activates the integration of the resource,
+// or deactivates it when it should not be active.
+func setProjectIntegration(d *schema.ResourceData, client *gitlab.Client) error {
+	project, slug, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if !d.Get("active").(bool) {
+		log.Printf("[DEBUG] deactivate gitlab %s integration for project %s", slug, project)
+
+		return deleteProjectIntegration(client, project, slug)
+	}
+
+	options := map[string]interface{}{}
+	for _, key := range []string{"properties", "sensitive_properties"} {
+		// Properties which are no longer configured are reset.
+		old, _ := d.GetChange(key)
+		for property := range old.(map[string]interface{}) {
+			options[property] = nil
+		}
+		for property, value := range d.Get(key).(map[string]interface{}) {
+			options[property] = value
+		}
+	}
+	for _, event := range projectIntegrationEvents {
+		if v, ok := d.GetOkExists(event); ok {
+			options[event] = v
+		}
+	}
+
+	log.Printf("[DEBUG] set gitlab %s integration for project %s", slug, project)
+
+	req, err := client.NewRequest(http.MethodPut, projectIntegrationPath(project, slug), options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+func deleteProjectIntegration(client *gitlab.Client, project, slug string) error {
+	req, err := client.NewRequest(http.MethodDelete, projectIntegrationPath(project, slug), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+// flattenProjectIntegrationProperties converts the properties returned by GitLab,
+// which may be of any JSON type, to the strings of the properties map.
+func flattenProjectIntegrationProperties(properties map[string]interface{}) map[string]interface{} {
+	values := make(map[string]interface{}, len(properties))
+	for key, value := range properties {
+		switch v := value.(type) {
+		case nil:
+			values[key] = ""
+		case string:
+			values[key] = v
+		case bool:
+			values[key] = strconv.FormatBool(v)
+		case float64:
+			values[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			values[key] = string(b)
+		}
+	}
+	return values
+}
+
+// readProjectIntegrationProperties returns the configured properties as returned by GitLab.
+// GitLab also returns the defaults of the properties which are not configured, so these are ignored.
+// Secret properties, like passwords and tokens, are either not returned or masked, and unknown properties are
+// not returned at all, so these are left out and their keys are returned as missing.
+func readProjectIntegrationProperties(configured, returned map[string]interface{}) (map[string]interface{}, []string) {
+	values := flattenProjectIntegrationProperties(returned)
+
+	properties := make(map[string]interface{}, len(configured))
+	var missing []string
+	for key := range configured {
+		if v, ok := values[key]; ok && !isMaskedProjectIntegrationProperty(v.(string)) {
+			properties[key] = v
+			continue
+		}
+		missing = append(missing, key)
+	}
+	sort.Strings(missing)
+
+	return properties, missing
+}
+
+// isMaskedProjectIntegrationProperty returns true if the value of a property is masked, like "************".
+func isMaskedProjectIntegrationProperty(value string) bool {
+	return value != "" && strings.Trim(value, "*") == ""
+}
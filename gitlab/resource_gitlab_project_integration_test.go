@@ -0,0 +1,270 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectIntegration_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectIntegrationDestroy(client),
+		Steps: []resource.TestStep{
+			// Create an integration with properties
+			{
+				Config: testAccGitlabProjectIntegrationConfig(project.ID, "emails-on-push", `
+  push_events     = true
+  tag_push_events = false
+
+  properties = {
+    recipients    = "test@example.com"
+    disable_diffs = "true"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "active", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "title", "Emails on push"),
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "push_events", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "tag_push_events", "false"),
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "properties.%", "2"),
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "properties.recipients", "test@example.com"),
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "properties.disable_diffs", "true"),
+				),
+			},
+			// Update the properties
+			{
+				Config: testAccGitlabProjectIntegrationConfig(project.ID, "emails-on-push", `
+  properties = {
+    recipients = "test@example.com test2@example.com"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "properties.%", "1"),
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "properties.recipients", "test@example.com test2@example.com"),
+				),
+			},
+			// Verify import, which imports all properties which are set in GitLab
+			{
+				ResourceName: "gitlab_project_integration.foo",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if got := states[0].Attributes["properties.recipients"]; got != "test@example.com test2@example.com" {
+						return fmt.Errorf("expected imported recipients property, got %q", got)
+					}
+					if got := states[0].Attributes["properties.branches_to_be_notified"]; got != "all" {
+						return fmt.Errorf("expected imported branches_to_be_notified property, got %q", got)
+					}
+					return nil
+				},
+			},
+			// Only the configured properties are read
+			{
+				Config: testAccGitlabProjectIntegrationConfig(project.ID, "emails-on-push", `
+  properties = {
+    recipients = "test@example.com test2@example.com"
+  }`),
+				PlanOnly: true,
+			},
+			// Deactivate the integration
+			{
+				Config: testAccGitlabProjectIntegrationConfig(project.ID, "emails-on-push", `
+  active = false`),
+				Check: resource.TestCheckResourceAttr("gitlab_project_integration.foo", "active", "false"),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectIntegration_eventsOnly(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	// The recipients are required, so they are set outside of Terraform.
+	req, err := client.NewRequest(http.MethodPut, projectIntegrationPath(fmt.Sprintf("%d", project.ID), "emails-on-push"), map[string]interface{}{
+		"recipients": "test@example.com",
+	}, nil)
+	if err != nil {
+		t.Fatalf("could not set up the emails on push integration: %v", err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("could not set up the emails on push integration: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectIntegrationDestroy(client),
+		Steps: []resource.TestStep{
+			// Configuring only event flags neither reads nor resets any property
+			{
+				Config: testAccGitlabProjectIntegrationConfig(project.ID, "emails-on-push", `
+  push_events = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "properties.%", "0"),
+					testAccCheckGitlabProjectIntegrationProperty(client, project.ID, "emails-on-push", "recipients", "test@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectIntegration_sensitiveProperties(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectIntegrationDestroy(client),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabProjectIntegrationConfig(project.ID, "jenkins", `
+  properties = {
+    jenkins_url  = "https://jenkins.example.com"
+    project_name = "foo"
+    username     = "gitlab"
+  }
+
+  sensitive_properties = {
+    password = "secret"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "properties.jenkins_url", "https://jenkins.example.com"),
+					resource.TestCheckResourceAttr("gitlab_project_integration.foo", "sensitive_properties.password", "secret"),
+				),
+			},
+			// The secret properties are not returned by GitLab
+			{
+				ResourceName:            "gitlab_project_integration.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sensitive_properties"},
+			},
+			// A secret property in the properties map is rejected
+			{
+				Config: testAccGitlabProjectIntegrationConfig(project.ID, "jenkins", `
+  properties = {
+    jenkins_url  = "https://jenkins.example.com"
+    project_name = "foo"
+    username     = "gitlab"
+    password     = "secret"
+  }`),
+				ExpectError: regexp.MustCompile("does not return the properties password"),
+			},
+		},
+	})
+}
+
+func TestFlattenProjectIntegrationProperties(t *testing.T) {
+	properties := map[string]interface{}{
+		"url":     "https://example.com",
+		"enabled": true,
+		"port":    float64(8080),
+		"unset":   nil,
+		"list":    []interface{}{"a", "b"},
+	}
+	expected := map[string]interface{}{
+		"url":     "https://example.com",
+		"enabled": "true",
+		"port":    "8080",
+		"unset":   "",
+		"list":    `["a","b"]`,
+	}
+
+	if got := flattenProjectIntegrationProperties(properties); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestReadProjectIntegrationProperties(t *testing.T) {
+	configured := map[string]interface{}{
+		"recipients": "test@example.com",
+		"password":   "secret",
+		"token":      "secret",
+	}
+	returned := map[string]interface{}{
+		"recipients":              "other@example.com",
+		"token":                   "************",
+		"branches_to_be_notified": "all",
+	}
+
+	properties, missing := readProjectIntegrationProperties(configured, returned)
+
+	expectedProperties := map[string]interface{}{
+		"recipients": "other@example.com",
+	}
+	if !reflect.DeepEqual(properties, expectedProperties) {
+		t.Fatalf("expected properties %v, got %v", expectedProperties, properties)
+	}
+	if expectedMissing := []string{"password", "token"}; !reflect.DeepEqual(missing, expectedMissing) {
+		t.Fatalf("expected missing %v, got %v", expectedMissing, missing)
+	}
+}
+
+func testAccCheckGitlabProjectIntegrationProperty(client *gitlab.Client, project int, slug, key, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		integration, err := getProjectIntegration(client, fmt.Sprintf("%d", project), slug)
+		if err != nil {
+			return err
+		}
+
+		if got := flattenProjectIntegrationProperties(integration.Properties)[key]; got != want {
+			return fmt.Errorf("expected property %s of the %s integration to be %q, got %q", key, slug, want, got)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabProjectIntegrationDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_project_integration" {
+				continue
+			}
+
+			project, slug, err := parseTwoPartID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			req, err := client.NewRequest(http.MethodGet, projectIntegrationPath(project, slug), nil, nil)
+			if err != nil {
+				return err
+			}
+
+			integration := new(projectIntegration)
+			_, err = client.Do(req, integration)
+			if err == nil {
+				if integration.Active {
+					return fmt.Errorf("%s integration in project %s is still active", slug, project)
+				}
+				continue
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabProjectIntegrationConfig(project int, slug, arguments string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project_integration" "foo" {
+  project = %d
+  slug    = %q
+%s
+}`, project, slug, arguments)
+}
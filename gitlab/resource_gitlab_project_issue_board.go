@@ -0,0 +1,575 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// https://docs.gitlab.com/ee/api/boards.html
+//
+// The issue boards are managed with raw requests, because go-gitlab neither supports the scope of a board,
+// nor assignee and milestone lists. The project and group boards APIs only differ in their paths.
+
+func resourceGitlabProjectIssueBoard() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabProjectIssueBoardCreate,
+		Read:   resourceGitlabProjectIssueBoardRead,
+		Update: resourceGitlabProjectIssueBoardUpdate,
+		Delete: resourceGitlabProjectIssueBoardDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: issueBoardSchema("project"),
+	}
+}
+
+// issueBoardSchema returns the schema of a project or group issue board,
+// which belongs to the project or group given by the parent attribute.
+func issueBoardSchema(parent string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		parent: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"lists": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"label_id": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"assignee_id": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"milestone_id": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"milestone_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"assignee_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"labels": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Set:      schema.HashString,
+		},
+		"weight": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+}
+
+// issueBoard represents a project or group issue board, including its scope.
+type issueBoard struct {
+	ID        int               `json:"id"`
+	Name      string            `json:"name"`
+	Milestone *gitlab.Milestone `json:"milestone"`
+	Assignee  *gitlab.BasicUser `json:"assignee"`
+	Labels    []*gitlab.Label   `json:"labels"`
+	Weight    *int              `json:"weight"`
+	Lists     []*issueBoardList `json:"lists"`
+}
+
+// issueBoardList represents a label, assignee or milestone list of an issue board.
+type issueBoardList struct {
+	ID        int               `json:"id"`
+	Label     *gitlab.Label     `json:"label"`
+	Assignee  *gitlab.BasicUser `json:"assignee"`
+	Milestone *gitlab.Milestone `json:"milestone"`
+	Position  int               `json:"position"`
+}
+
+type createIssueBoardListOptions struct {
+	LabelID     *int `url:"label_id,omitempty" json:"label_id,omitempty"`
+	AssigneeID  *int `url:"assignee_id,omitempty" json:"assignee_id,omitempty"`
+	MilestoneID *int `url:"milestone_id,omitempty" json:"milestone_id,omitempty"`
+}
+
+var errInvalidIssueBoardList = errors.New("each lists block must set exactly one of label_id, assignee_id or milestone_id")
+
+func resourceGitlabProjectIssueBoardCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	log.Printf("[DEBUG] create gitlab issue board %q in project %s", d.Get("name").(string), project)
+
+	board, err := createIssueBoard(d, client, fmt.Sprintf("projects/%s/boards", url.PathEscape(project)))
+	if board != nil {
+		boardID := strconv.Itoa(board.ID)
+		d.SetId(buildTwoPartID(&project, &boardID))
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectIssueBoardRead(d, meta)
+}
+
+func resourceGitlabProjectIssueBoardRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, boardID, err := parseIssueBoardID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab issue board %d in project %s", boardID, project)
+
+	board, err := getIssueBoard(client, fmt.Sprintf("projects/%s/boards/%d", url.PathEscape(project), boardID))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab issue board %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	return setIssueBoardState(d, board)
+}
+
+func resourceGitlabProjectIssueBoardUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, boardID, err := parseIssueBoardID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] update gitlab issue board %s", d.Id())
+
+	if err := updateIssueBoard(d, client, fmt.Sprintf("projects/%s/boards/%d", url.PathEscape(project), boardID)); err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectIssueBoardRead(d, meta)
+}
+
+func resourceGitlabProjectIssueBoardDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, boardID, err := parseIssueBoardID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Delete gitlab issue board %s", d.Id())
+
+	_, err = client.Boards.DeleteIssueBoard(project, boardID)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+// createIssueBoard creates the board, then sets its scope and adds its lists,
+// as the create endpoint only supports the name of the board.
+// The board is returned along with the error if one of the later steps fails.
+func createIssueBoard(d *schema.ResourceData, client *gitlab.Client, boardsPath string) (*issueBoard, error) {
+	lists, err := expandIssueBoardLists(d.Get("lists").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := client.NewRequest(http.MethodPost, boardsPath, &gitlab.CreateIssueBoardOptions{
+		Name: gitlab.String(d.Get("name").(string)),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	board := new(issueBoard)
+	if _, err := client.Do(req, board); err != nil {
+		return nil, err
+	}
+
+	boardPath := fmt.Sprintf("%s/%d", boardsPath, board.ID)
+
+	if scope := expandIssueBoardScope(d, true); len(scope) > 0 {
+		if err := putIssueBoard(client, boardPath, scope); err != nil {
+			return board, err
+		}
+	}
+
+	return board, syncIssueBoardLists(client, boardPath, lists)
+}
+
+func updateIssueBoard(d *schema.ResourceData, client *gitlab.Client, boardPath string) error {
+	options := expandIssueBoardScope(d, false)
+	if d.HasChange("name") {
+		options["name"] = d.Get("name").(string)
+	}
+
+	if len(options) > 0 {
+		if err := putIssueBoard(client, boardPath, options); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("lists") {
+		lists, err := expandIssueBoardLists(d.Get("lists").([]interface{}))
+		if err != nil {
+			return err
+		}
+		return syncIssueBoardLists(client, boardPath, lists)
+	}
+
+	return nil
+}
+
+func putIssueBoard(client *gitlab.Client, boardPath string, options map[string]interface{}) error {
+	req, err := client.NewRequest(http.MethodPut, boardPath, options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+func getIssueBoard(client *gitlab.Client, boardPath string) (*issueBoard, error) {
+	req, err := client.NewRequest(http.MethodGet, boardPath, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	board := new(issueBoard)
+	if _, err := client.Do(req, board); err != nil {
+		return nil, err
+	}
+
+	lists, err := getIssueBoardLists(client, boardPath)
+	if err != nil {
+		return nil, err
+	}
+	board.Lists = lists
+
+	return board, nil
+}
+
+// getIssueBoardLists returns the label, assignee and milestone lists of the board, ordered by their position.
+// The open and closed lists are left out, as they cannot be managed.
+func getIssueBoardLists(client *gitlab.Client, boardPath string) ([]*issueBoardList, error) {
+	req, err := client.NewRequest(http.MethodGet, boardPath+"/lists", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var lists []*issueBoardList
+	if _, err := client.Do(req, &lists); err != nil {
+		return nil, err
+	}
+
+	result := make([]*issueBoardList, 0, len(lists))
+	for _, list := range lists {
+		if list.Label != nil || list.Assignee != nil || list.Milestone != nil {
+			result = append(result, list)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Position < result[j].Position
+	})
+
+	return result, nil
+}
+
+// syncIssueBoardLists makes the lists of the board match the given lists.
+// It deletes the lists which are gone and adds the new ones at the end of the board,
+// then moves the lists into place, so that reordering the lists keeps them and their settings.
+func syncIssueBoardLists(client *gitlab.Client, boardPath string, lists []*createIssueBoardListOptions) error {
+	current, err := getIssueBoardLists(client, boardPath)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool, len(lists))
+	for _, list := range lists {
+		wanted[issueBoardListKey(list.LabelID, list.AssigneeID, list.MilestoneID)] = true
+	}
+
+	// order holds the keys of the lists of the board in their current order, and ids their IDs.
+	var order []string
+	ids := make(map[string]int, len(current))
+
+	for _, list := range current {
+		key := issueBoardListKey(flattenIssueBoardList(list))
+		if wanted[key] {
+			order = append(order, key)
+			ids[key] = list.ID
+			continue
+		}
+
+		log.Printf("[DEBUG] Delete list %d of gitlab issue board %s", list.ID, boardPath)
+
+		req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("%s/lists/%d", boardPath, list.ID), nil, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := client.Do(req, nil); err != nil && !is404(err) {
+			return err
+		}
+	}
+
+	for _, list := range lists {
+		key := issueBoardListKey(list.LabelID, list.AssigneeID, list.MilestoneID)
+		if _, ok := ids[key]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] create list %s of gitlab issue board %s", key, boardPath)
+
+		req, err := client.NewRequest(http.MethodPost, boardPath+"/lists", list, nil)
+		if err != nil {
+			return err
+		}
+
+		created := new(issueBoardList)
+		if _, err := client.Do(req, created); err != nil {
+			return err
+		}
+
+		order = append(order, key)
+		ids[key] = created.ID
+	}
+
+	keys := make([]string, 0, len(lists))
+	for _, list := range lists {
+		keys = append(keys, issueBoardListKey(list.LabelID, list.AssigneeID, list.MilestoneID))
+	}
+
+	for _, move := range issueBoardListMoves(order, keys) {
+		log.Printf("[DEBUG] move list %s of gitlab issue board %s to position %d", move.key, boardPath, move.position)
+
+		req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("%s/lists/%d", boardPath, ids[move.key]), &gitlab.UpdateIssueBoardListOptions{
+			Position: gitlab.Int(move.position),
+		}, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := client.Do(req, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type issueBoardListMove struct {
+	key      string
+	position int
+}
+
+// issueBoardListMoves returns the moves which turn the current order of the lists into the wanted one,
+// where both orders hold the same keys. Moving a list shifts the lists between its old and its new position,
+// so moving the lists into place from the first position on never moves a list which is already in place.
+func issueBoardListMoves(current, wanted []string) []issueBoardListMove {
+	order := append([]string(nil), current...)
+	var moves []issueBoardListMove
+
+	for position, key := range wanted {
+		if position >= len(order) {
+			break
+		}
+		if order[position] == key {
+			continue
+		}
+
+		for i := position + 1; i < len(order); i++ {
+			if order[i] == key {
+				copy(order[position+1:i+1], order[position:i])
+				order[position] = key
+				break
+			}
+		}
+
+		moves = append(moves, issueBoardListMove{key: key, position: position})
+	}
+
+	return moves
+}
+
+// expandIssueBoardScope returns the changed scope of the board, where a removed scope is null.
+// On create, it returns the configured scope.
+func expandIssueBoardScope(d *schema.ResourceData, create bool) map[string]interface{} {
+	options := make(map[string]interface{})
+	changed := func(key string) bool {
+		if create {
+			_, ok := d.GetOk(key)
+			return ok
+		}
+		return d.HasChange(key)
+	}
+
+	for _, key := range []string{"milestone_id", "assignee_id", "weight"} {
+		if !changed(key) {
+			continue
+		}
+		if v, ok := d.GetOk(key); ok {
+			options[key] = v.(int)
+		} else {
+			options[key] = nil
+		}
+	}
+
+	if changed("labels") {
+		options["labels"] = (*gitlab.Labels)(stringSetToStringSlice(d.Get("labels").(*schema.Set)))
+	}
+
+	return options
+}
+
+// expandIssueBoardLists returns the configured lists, which must be unique
+// as a board has at most one list for each label, assignee and milestone.
+func expandIssueBoardLists(lists []interface{}) ([]*createIssueBoardListOptions, error) {
+	result := make([]*createIssueBoardListOptions, 0, len(lists))
+	keys := make(map[string]bool, len(lists))
+	for _, v := range lists {
+		if v == nil {
+			return nil, errInvalidIssueBoardList
+		}
+		m := v.(map[string]interface{})
+		list := &createIssueBoardListOptions{}
+		set := 0
+
+		if id := m["label_id"].(int); id != 0 {
+			list.LabelID = gitlab.Int(id)
+			set++
+		}
+		if id := m["assignee_id"].(int); id != 0 {
+			list.AssigneeID = gitlab.Int(id)
+			set++
+		}
+		if id := m["milestone_id"].(int); id != 0 {
+			list.MilestoneID = gitlab.Int(id)
+			set++
+		}
+
+		if set != 1 {
+			return nil, errInvalidIssueBoardList
+		}
+
+		key := issueBoardListKey(list.LabelID, list.AssigneeID, list.MilestoneID)
+		if keys[key] {
+			return nil, fmt.Errorf("the lists of an issue board must be unique, but list %s is set more than once", key)
+		}
+		keys[key] = true
+
+		result = append(result, list)
+	}
+	return result, nil
+}
+
+func flattenIssueBoardList(list *issueBoardList) (labelID, assigneeID, milestoneID *int) {
+	switch {
+	case list.Label != nil:
+		labelID = &list.Label.ID
+	case list.Assignee != nil:
+		assigneeID = &list.Assignee.ID
+	case list.Milestone != nil:
+		milestoneID = &list.Milestone.ID
+	}
+	return labelID, assigneeID, milestoneID
+}
+
+func issueBoardListKey(labelID, assigneeID, milestoneID *int) string {
+	switch {
+	case labelID != nil:
+		return fmt.Sprintf("label:%d", *labelID)
+	case assigneeID != nil:
+		return fmt.Sprintf("assignee:%d", *assigneeID)
+	case milestoneID != nil:
+		return fmt.Sprintf("milestone:%d", *milestoneID)
+	}
+	return ""
+}
+
+func setIssueBoardState(d *schema.ResourceData, board *issueBoard) error {
+	d.Set("name", board.Name)
+
+	lists := make([]map[string]interface{}, 0, len(board.Lists))
+	for _, list := range board.Lists {
+		labelID, assigneeID, milestoneID := flattenIssueBoardList(list)
+		m := map[string]interface{}{}
+		if labelID != nil {
+			m["label_id"] = *labelID
+		}
+		if assigneeID != nil {
+			m["assignee_id"] = *assigneeID
+		}
+		if milestoneID != nil {
+			m["milestone_id"] = *milestoneID
+		}
+		lists = append(lists, m)
+	}
+	if err := d.Set("lists", lists); err != nil {
+		return fmt.Errorf("error setting lists: %v", err)
+	}
+
+	if board.Milestone != nil {
+		d.Set("milestone_id", board.Milestone.ID)
+	} else {
+		d.Set("milestone_id", 0)
+	}
+	if board.Assignee != nil {
+		d.Set("assignee_id", board.Assignee.ID)
+	} else {
+		d.Set("assignee_id", 0)
+	}
+	labels := make([]string, 0, len(board.Labels))
+	for _, label := range board.Labels {
+		labels = append(labels, label.Name)
+	}
+	if err := d.Set("labels", labels); err != nil {
+		return fmt.Errorf("error setting labels: %v", err)
+	}
+	if board.Weight != nil {
+		d.Set("weight", *board.Weight)
+	} else {
+		d.Set("weight", 0)
+	}
+
+	return nil
+}
+
+// parseIssueBoardID parses the `project:board_id` or `group:board_id` ID of an issue board.
+func parseIssueBoardID(id string) (string, int, error) {
+	parent, rawBoardID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	boardID, err := strconv.Atoi(rawBoardID)
+	if err != nil {
+		return "", 0, fmt.Errorf("Unexpected board ID %q in ID %q: %w", rawBoardID, id, err)
+	}
+
+	return parent, boardID, nil
+}
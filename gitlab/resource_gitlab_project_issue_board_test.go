@@ -0,0 +1,264 @@
+package gitlab
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectIssueBoard_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabIssueBoardDestroy(client, "gitlab_project_issue_board"),
+		Steps: []resource.TestStep{
+			// Create a board with label lists
+			{
+				Config: testAccGitlabProjectIssueBoardConfig(project.ID, "Kanban", []string{"todo", "doing", "review"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.foo", "name", "Kanban"),
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.foo", "lists.#", "3"),
+					resource.TestCheckResourceAttrPair("gitlab_project_issue_board.foo", "lists.0.label_id", "gitlab_label.todo", "label_id"),
+					resource.TestCheckResourceAttrPair("gitlab_project_issue_board.foo", "lists.1.label_id", "gitlab_label.doing", "label_id"),
+					resource.TestCheckResourceAttrPair("gitlab_project_issue_board.foo", "lists.2.label_id", "gitlab_label.review", "label_id"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_issue_board.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Reorder the lists and rename the board, which keeps the board and its lists
+			{
+				Config: testAccGitlabProjectIssueBoardConfig(project.ID, "Workflow", []string{"review", "todo", "doing"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.foo", "name", "Workflow"),
+					resource.TestCheckResourceAttrPair("gitlab_project_issue_board.foo", "lists.0.label_id", "gitlab_label.review", "label_id"),
+					resource.TestCheckResourceAttrPair("gitlab_project_issue_board.foo", "lists.1.label_id", "gitlab_label.todo", "label_id"),
+					resource.TestCheckResourceAttrPair("gitlab_project_issue_board.foo", "lists.2.label_id", "gitlab_label.doing", "label_id"),
+				),
+			},
+			// Remove a list
+			{
+				Config: testAccGitlabProjectIssueBoardConfig(project.ID, "Workflow", []string{"doing", "review"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.foo", "lists.#", "2"),
+					resource.TestCheckResourceAttrPair("gitlab_project_issue_board.foo", "lists.0.label_id", "gitlab_label.doing", "label_id"),
+					resource.TestCheckResourceAttrPair("gitlab_project_issue_board.foo", "lists.1.label_id", "gitlab_label.review", "label_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectIssueBoard_EE(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	testAccCheckEE(t, client)
+
+	project := testAccCreateProject(t, client)
+	user := testAccCreateUsers(t, client, 1)[0]
+	testAccAddProjectMembers(t, client, project.ID, []*gitlab.User{user})
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabIssueBoardDestroy(client, "gitlab_project_issue_board"),
+		Steps: []resource.TestStep{
+			// Create a scoped board with assignee and milestone lists
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_milestone" "foo" {
+  project = %d
+  title   = "1.0"
+}
+
+resource "gitlab_project_issue_board" "foo" {
+  project      = %d
+  name         = "Scoped"
+  milestone_id = gitlab_project_milestone.foo.milestone_id
+  labels       = ["bug"]
+  weight       = 2
+
+  lists {
+    assignee_id = %d
+  }
+
+  lists {
+    milestone_id = gitlab_project_milestone.foo.milestone_id
+  }
+}`, project.ID, project.ID, user.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("gitlab_project_issue_board.foo", "milestone_id", "gitlab_project_milestone.foo", "milestone_id"),
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.foo", "labels.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.foo", "weight", "2"),
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.foo", "lists.0.assignee_id", fmt.Sprintf("%d", user.ID)),
+					resource.TestCheckResourceAttrPair("gitlab_project_issue_board.foo", "lists.1.milestone_id", "gitlab_project_milestone.foo", "milestone_id"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_issue_board.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestIssueBoardListMoves(t *testing.T) {
+	cases := []struct {
+		Current  []string
+		Wanted   []string
+		Expected []issueBoardListMove
+	}{
+		{
+			Current:  []string{"a", "b", "c"},
+			Wanted:   []string{"a", "b", "c"},
+			Expected: nil,
+		},
+		{
+			Current:  []string{"a", "b", "c"},
+			Wanted:   []string{"c", "a", "b"},
+			Expected: []issueBoardListMove{{key: "c", position: 0}},
+		},
+		{
+			Current:  []string{"a", "b", "c"},
+			Wanted:   []string{"b", "c", "a"},
+			Expected: []issueBoardListMove{{key: "b", position: 0}, {key: "c", position: 1}},
+		},
+		{
+			Current:  []string{"a", "b", "c", "d"},
+			Wanted:   []string{"d", "c", "b", "a"},
+			Expected: []issueBoardListMove{{key: "d", position: 0}, {key: "c", position: 1}, {key: "b", position: 2}},
+		},
+		// Duplicated lists are rejected by expandIssueBoardLists, but must not make the moves panic
+		{
+			Current:  []string{"a", "b"},
+			Wanted:   []string{"b", "a", "a"},
+			Expected: []issueBoardListMove{{key: "b", position: 0}},
+		},
+	}
+
+	for _, tc := range cases {
+		if got := issueBoardListMoves(tc.Current, tc.Wanted); !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("moves from %v to %v: got %v expected %v", tc.Current, tc.Wanted, got, tc.Expected)
+		}
+	}
+}
+
+func TestExpandIssueBoardLists(t *testing.T) {
+	cases := []struct {
+		Lists       []interface{}
+		ExpectError bool
+	}{
+		{
+			Lists: []interface{}{
+				map[string]interface{}{"label_id": 1, "assignee_id": 0, "milestone_id": 0},
+				map[string]interface{}{"label_id": 0, "assignee_id": 1, "milestone_id": 0},
+				map[string]interface{}{"label_id": 0, "assignee_id": 0, "milestone_id": 1},
+			},
+			ExpectError: false,
+		},
+		{
+			Lists: []interface{}{
+				map[string]interface{}{"label_id": 1, "assignee_id": 0, "milestone_id": 0},
+				map[string]interface{}{"label_id": 1, "assignee_id": 0, "milestone_id": 0},
+			},
+			ExpectError: true,
+		},
+		{
+			Lists: []interface{}{
+				map[string]interface{}{"label_id": 0, "assignee_id": 2, "milestone_id": 0},
+				map[string]interface{}{"label_id": 0, "assignee_id": 2, "milestone_id": 0},
+			},
+			ExpectError: true,
+		},
+		{
+			Lists: []interface{}{
+				map[string]interface{}{"label_id": 1, "assignee_id": 1, "milestone_id": 0},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := expandIssueBoardLists(tc.Lists)
+		if (err != nil) != tc.ExpectError {
+			t.Fatalf("lists %v: got error %v, expected error %v", tc.Lists, err, tc.ExpectError)
+		}
+	}
+}
+
+func testAccCheckGitlabIssueBoardDestroy(client *gitlab.Client, resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			parent, boardID, err := parseIssueBoardID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			if resourceType == "gitlab_group_issue_board" {
+				_, _, err = client.GroupIssueBoards.GetGroupIssueBoard(parent, boardID)
+			} else {
+				_, _, err = client.Boards.GetIssueBoard(parent, boardID)
+			}
+			if err == nil {
+				return fmt.Errorf("issue board %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabProjectIssueBoardConfig(project int, name string, lists []string) string {
+	config := fmt.Sprintf(`
+resource "gitlab_label" "todo" {
+  project = %[1]d
+  name    = "todo"
+  color   = "#ffcc00"
+}
+
+resource "gitlab_label" "doing" {
+  project = %[1]d
+  name    = "doing"
+  color   = "#0033cc"
+}
+
+resource "gitlab_label" "review" {
+  project = %[1]d
+  name    = "review"
+  color   = "#00cc33"
+}
+
+resource "gitlab_project_issue_board" "foo" {
+  project = %[1]d
+  name    = %[2]q
+`, project, name)
+
+	for _, label := range lists {
+		config += fmt.Sprintf(`
+  lists {
+    label_id = gitlab_label.%s.label_id
+  }
+`, label)
+	}
+
+	return config + "}"
+}
@@ -0,0 +1,395 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabProjectMembers() *schema.Resource {
+	acceptedAccessLevels := make([]string, 0, len(accessLevelID))
+	for k := range accessLevelID {
+		if k != "owner" {
+			acceptedAccessLevels = append(acceptedAccessLevels, k)
+		}
+	}
+	return &schema.Resource{
+		Create: resourceGitlabProjectMembersCreate,
+		Read:   resourceGitlabProjectMembersRead,
+		Update: resourceGitlabProjectMembersUpdate,
+		Delete: resourceGitlabProjectMembersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: membersSchema("project", acceptedAccessLevels),
+	}
+}
+
+func resourceGitlabProjectMembersCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("project").(string))
+
+	return resourceGitlabProjectMembersUpdate(d, meta)
+}
+
+func resourceGitlabProjectMembersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab project members of %s", project)
+
+	members, err := listDirectMembers(client, projectMembersPath(project))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found so removing its members from state", project)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	return setMembersState(d, members)
+}
+
+func resourceGitlabProjectMembersUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] update gitlab project members of %s", project)
+
+	if err := syncMembers(d, client, projectMembersPath(project)); err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectMembersRead(d, meta)
+}
+
+func resourceGitlabProjectMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab project members of %s", project)
+
+	return deleteMembers(d, client, projectMembersPath(project))
+}
+
+func projectMembersPath(project string) string {
+	return fmt.Sprintf("projects/%s/members", url.PathEscape(project))
+}
+
+// membersSchema returns the schema of a resource managing all direct members of a project or group.
+// Members with owner access can only be managed if owner is one of the access levels,
+// otherwise they are always exempt and there is no exempt_owners attribute.
+func membersSchema(parent string, accessLevels []string) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		parent: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"members": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"user_id": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"username": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"access_level": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateValueFunc(accessLevels),
+					},
+					"expires_at": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateDateFunc,
+					},
+				},
+			},
+		},
+		"exempt_bots": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"skip_users": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	for _, level := range accessLevels {
+		if level == "owner" {
+			s["exempt_owners"] = &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			}
+		}
+	}
+
+	return s
+}
+
+// directMember is a direct member of a project or group.
+type directMember struct {
+	ID          int                     `json:"id"`
+	Username    string                  `json:"username"`
+	AccessLevel gitlab.AccessLevelValue `json:"access_level"`
+	ExpiresAt   *gitlab.ISOTime         `json:"expires_at"`
+}
+
+// memberOptions adds or edits a member of a project or group.
+// A nil expiration date removes the expiration of the membership.
+type memberOptions struct {
+	UserID      *int                     `json:"user_id,omitempty"`
+	AccessLevel *gitlab.AccessLevelValue `json:"access_level,omitempty"`
+	ExpiresAt   *string                  `json:"expires_at"`
+}
+
+// botUsernameRegexp matches the usernames of the bot users of project and group access tokens.
+var botUsernameRegexp = regexp.MustCompile(`^(project|group)_\d+_bot`)
+
+// isExemptMember returns true if the member is neither removed nor read,
+// unless it is part of the configured members.
+// Owners are always exempt when the resource cannot manage them, like the owner of a personal project.
+func isExemptMember(d *schema.ResourceData, member *directMember) bool {
+	if member.AccessLevel == gitlab.OwnerPermission {
+		if exemptOwners, ok := d.Get("exempt_owners").(bool); !ok || exemptOwners {
+			return true
+		}
+	}
+	if d.Get("skip_users").(*schema.Set).Contains(member.Username) {
+		return true
+	}
+	return d.Get("exempt_bots").(bool) && botUsernameRegexp.MatchString(member.Username)
+}
+
+func listDirectMembers(client *gitlab.Client, membersPath string) ([]*directMember, error) {
+	options := &gitlab.ListOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	var members []*directMember
+	for options.Page != 0 {
+		req, err := client.NewRequest(http.MethodGet, membersPath, options, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []*directMember
+		resp, err := client.Do(req, &page)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, page...)
+
+		options.Page = resp.NextPage
+	}
+
+	return members, nil
+}
+
+// memberReferences returns the user IDs and usernames the members are configured with.
+func memberReferences(d *schema.ResourceData) (map[int]bool, map[string]bool) {
+	ids := map[int]bool{}
+	usernames := map[string]bool{}
+	for _, v := range d.Get("members").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		if id := m["user_id"].(int); id != 0 {
+			ids[id] = true
+		}
+		if username := m["username"].(string); username != "" {
+			usernames[username] = true
+		}
+	}
+	return ids, usernames
+}
+
+func setMembersState(d *schema.ResourceData, members []*directMember) error {
+	ids, usernames := memberReferences(d)
+
+	var state []map[string]interface{}
+	for _, member := range members {
+		byID, byUsername := ids[member.ID], usernames[member.Username]
+		if !byID && !byUsername && isExemptMember(d, member) {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"access_level": accessLevel[member.AccessLevel],
+		}
+		// Keep referring to the member the same way as the configuration.
+		if byUsername && !byID {
+			m["username"] = member.Username
+		} else {
+			m["user_id"] = member.ID
+		}
+		if member.ExpiresAt != nil {
+			m["expires_at"] = member.ExpiresAt.String()
+		}
+		state = append(state, m)
+	}
+
+	return d.Set("members", state)
+}
+
+// expandMembers returns the configured members by user ID, looking up the users configured by username.
+func expandMembers(d *schema.ResourceData, client *gitlab.Client) (map[int]*memberOptions, error) {
+	members := map[int]*memberOptions{}
+	for _, v := range d.Get("members").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+
+		id, username := m["user_id"].(int), m["username"].(string)
+		if (id == 0) == (username == "") {
+			return nil, fmt.Errorf("each member must have either a user_id or a username")
+		}
+		if username != "" {
+			user, err := findUserByUsername(client, username)
+			if err != nil {
+				return nil, err
+			}
+			id = user.ID
+		}
+		if _, ok := members[id]; ok {
+			return nil, fmt.Errorf("user %d is configured as member more than once", id)
+		}
+
+		options := &memberOptions{
+			UserID:      gitlab.Int(id),
+			AccessLevel: gitlab.AccessLevel(accessLevelID[m["access_level"].(string)]),
+		}
+		if expiresAt := m["expires_at"].(string); expiresAt != "" {
+			options.ExpiresAt = gitlab.String(expiresAt)
+		}
+		members[id] = options
+	}
+	return members, nil
+}
+
+func findUserByUsername(client *gitlab.Client, username string) (*gitlab.User, error) {
+	users, _, err := client.Users.ListUsers(&gitlab.ListUsersOptions{
+		Username: gitlab.String(username),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("user %q not found", username)
+	}
+	return users[0], nil
+}
+
+// syncMembers adds, edits and removes direct members until they match the configured members.
+func syncMembers(d *schema.ResourceData, client *gitlab.Client, membersPath string) error {
+	wanted, err := expandMembers(d, client)
+	if err != nil {
+		return err
+	}
+
+	current, err := listDirectMembers(client, membersPath)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range current {
+		options, ok := wanted[member.ID]
+		if !ok {
+			if isExemptMember(d, member) {
+				continue
+			}
+
+			log.Printf("[DEBUG] remove member %s from %s", member.Username, membersPath)
+
+			if err := doMemberRequest(client, http.MethodDelete, fmt.Sprintf("%s/%d", membersPath, member.ID), nil); err != nil {
+				return err
+			}
+			continue
+		}
+		delete(wanted, member.ID)
+
+		currentExpiresAt, wantedExpiresAt := "", ""
+		if member.ExpiresAt != nil {
+			currentExpiresAt = member.ExpiresAt.String()
+		}
+		if options.ExpiresAt != nil {
+			wantedExpiresAt = *options.ExpiresAt
+		}
+		if *options.AccessLevel == member.AccessLevel && wantedExpiresAt == currentExpiresAt {
+			continue
+		}
+
+		log.Printf("[DEBUG] edit member %s of %s", member.Username, membersPath)
+
+		options.UserID = nil
+		if err := doMemberRequest(client, http.MethodPut, fmt.Sprintf("%s/%d", membersPath, member.ID), options); err != nil {
+			return err
+		}
+	}
+
+	for id, options := range wanted {
+		log.Printf("[DEBUG] add member %d to %s", id, membersPath)
+
+		if err := doMemberRequest(client, http.MethodPost, membersPath, options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteMembers removes the direct members which are part of the state.
+func deleteMembers(d *schema.ResourceData, client *gitlab.Client, membersPath string) error {
+	ids, usernames := memberReferences(d)
+
+	current, err := listDirectMembers(client, membersPath)
+	if err != nil {
+		if is404(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, member := range current {
+		if !ids[member.ID] && !usernames[member.Username] {
+			continue
+		}
+
+		log.Printf("[DEBUG] remove member %s from %s", member.Username, membersPath)
+
+		if err := doMemberRequest(client, http.MethodDelete, fmt.Sprintf("%s/%d", membersPath, member.ID), nil); err != nil && !is404(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func doMemberRequest(client *gitlab.Client, method, path string, options *memberOptions) error {
+	var opt interface{}
+	if options != nil {
+		opt = options
+	}
+
+	req, err := client.NewRequest(method, path, opt, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
@@ -0,0 +1,216 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectMembers_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+	users := testAccCreateUsers(t, client, 3)
+	expiresAt := time.Now().AddDate(0, 1, 0).Format("2006-01-02")
+
+	// The third user is added outside of Terraform and must be removed.
+	testAccAddProjectMembers(t, client, project.ID, users[2:])
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectMembersDestroy(client, project.ID, users),
+		Steps: []resource.TestStep{
+			// Add members by user ID and by username
+			{
+				Config: testAccGitlabProjectMembersConfig(project.ID, fmt.Sprintf(`
+  members {
+    user_id      = %d
+    access_level = "developer"
+  }
+
+  members {
+    username     = %q
+    access_level = "reporter"
+  }`, users[0].ID, users[1].Username)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_members.foo", "members.#", "2"),
+					testAccCheckGitlabProjectMembers(client, project.ID, map[int]gitlab.AccessLevelValue{
+						users[0].ID: gitlab.DeveloperPermissions,
+						users[1].ID: gitlab.ReporterPermissions,
+					}),
+				),
+			},
+			// Detect a member which is added outside of Terraform
+			{
+				PreConfig: func() {
+					testAccAddProjectMembers(t, client, project.ID, users[2:])
+				},
+				Config: testAccGitlabProjectMembersConfig(project.ID, fmt.Sprintf(`
+  members {
+    user_id      = %d
+    access_level = "developer"
+  }
+
+  members {
+    username     = %q
+    access_level = "reporter"
+  }`, users[0].ID, users[1].Username)),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Change the access level, set an expiration date and remove the other members
+			{
+				Config: testAccGitlabProjectMembersConfig(project.ID, fmt.Sprintf(`
+  members {
+    user_id      = %d
+    access_level = "maintainer"
+    expires_at   = %q
+  }`, users[0].ID, expiresAt)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_members.foo", "members.#", "1"),
+					testAccCheckGitlabProjectMembers(client, project.ID, map[int]gitlab.AccessLevelValue{
+						users[0].ID: gitlab.MaintainerPermissions,
+					}),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_members.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestBotUsernameRegexp(t *testing.T) {
+	cases := []struct {
+		username string
+		isBot    bool
+	}{
+		{username: "project_42_bot", isBot: true},
+		{username: "project_42_bot1", isBot: true},
+		{username: "group_7_bot_3f2a1b", isBot: true},
+		{username: "project_bot", isBot: false},
+		{username: "my_project_42_bot", isBot: false},
+		{username: "root", isBot: false},
+	}
+
+	for _, c := range cases {
+		if isBot := botUsernameRegexp.MatchString(c.username); isBot != c.isBot {
+			t.Fatalf("expected %q to match %t, got %t", c.username, c.isBot, isBot)
+		}
+	}
+}
+
+func TestIsExemptMember(t *testing.T) {
+	owner := &directMember{ID: 1, Username: "owner", AccessLevel: gitlab.OwnerPermission}
+	developer := &directMember{ID: 2, Username: "developer", AccessLevel: gitlab.DeveloperPermissions}
+
+	cases := []struct {
+		name     string
+		resource *schema.Resource
+		raw      map[string]interface{}
+		member   *directMember
+		isExempt bool
+	}{
+		{
+			name:     "project owner is always exempt",
+			resource: resourceGitlabProjectMembers(),
+			raw:      map[string]interface{}{"project": "1", "exempt_bots": false},
+			member:   owner,
+			isExempt: true,
+		},
+		{
+			name:     "group owner is exempt by default",
+			resource: resourceGitlabGroupMembers(),
+			raw:      map[string]interface{}{"group": "1"},
+			member:   owner,
+			isExempt: true,
+		},
+		{
+			name:     "group owner is managed without exempt_owners",
+			resource: resourceGitlabGroupMembers(),
+			raw:      map[string]interface{}{"group": "1", "exempt_owners": false},
+			member:   owner,
+			isExempt: false,
+		},
+		{
+			name:     "skipped user is exempt",
+			resource: resourceGitlabProjectMembers(),
+			raw:      map[string]interface{}{"project": "1", "skip_users": []interface{}{"developer"}},
+			member:   developer,
+			isExempt: true,
+		},
+		{
+			name:     "developer is managed",
+			resource: resourceGitlabProjectMembers(),
+			raw:      map[string]interface{}{"project": "1"},
+			member:   developer,
+			isExempt: false,
+		},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, c.resource.Schema, c.raw)
+		if isExempt := isExemptMember(d, c.member); isExempt != c.isExempt {
+			t.Fatalf("%s: expected exempt %t, got %t", c.name, c.isExempt, isExempt)
+		}
+	}
+}
+
+// testAccCheckGitlabProjectMembers checks the direct members of the project,
+// ignoring the owner of the project.
+func testAccCheckGitlabProjectMembers(client *gitlab.Client, project int, expected map[int]gitlab.AccessLevelValue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		members, err := listDirectMembers(client, projectMembersPath(fmt.Sprintf("%d", project)))
+		if err != nil {
+			return err
+		}
+
+		got := map[int]gitlab.AccessLevelValue{}
+		for _, member := range members {
+			if member.AccessLevel != gitlab.OwnerPermission {
+				got[member.ID] = member.AccessLevel
+			}
+		}
+		if len(got) != len(expected) {
+			return fmt.Errorf("expected %d members, got %d: %v", len(expected), len(got), got)
+		}
+		for id, level := range expected {
+			if got[id] != level {
+				return fmt.Errorf("expected access level %d for user %d, got %d", level, id, got[id])
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabProjectMembersDestroy(client *gitlab.Client, project int, users []*gitlab.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, user := range users[:2] {
+			_, _, err := client.ProjectMembers.GetProjectMember(project, user.ID)
+			if err == nil {
+				return fmt.Errorf("user %d is still a member of project %d", user.ID, project)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabProjectMembersConfig(project int, members string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project_members" "foo" {
+  project = %d
+%s
+}`, project, members)
+}
@@ -0,0 +1,292 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabProjectMilestone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabProjectMilestoneCreate,
+		Read:   resourceGitlabProjectMilestoneRead,
+		Update: resourceGitlabProjectMilestoneUpdate,
+		Delete: resourceGitlabProjectMilestoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: milestoneSchema("project"),
+	}
+}
+
+// milestoneSchema returns the schema of a project or group milestone,
+// which belongs to the project or group given by the parent attribute.
+func milestoneSchema(parent string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		parent: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"title": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"start_date": {
+			Type:         schema.TypeString, // Format YYYY-MM-DD
+			Optional:     true,
+			ValidateFunc: validateDateFunc,
+		},
+		"due_date": {
+			Type:         schema.TypeString, // Format YYYY-MM-DD
+			Optional:     true,
+			ValidateFunc: validateDateFunc,
+		},
+		"state": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "active",
+			ValidateFunc: validateValueFunc([]string{"active", "closed"}),
+		},
+		"milestone_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"iid": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"expired": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+// updateMilestoneOptions replaces gitlab.UpdateMilestoneOptions and gitlab.UpdateGroupMilestoneOptions,
+// which cannot remove the start and due dates of a milestone.
+type updateMilestoneOptions struct {
+	Title       *string `url:"title,omitempty" json:"title,omitempty"`
+	Description *string `url:"description,omitempty" json:"description,omitempty"`
+	StartDate   *string `url:"start_date,omitempty" json:"start_date,omitempty"`
+	DueDate     *string `url:"due_date,omitempty" json:"due_date,omitempty"`
+	StateEvent  *string `url:"state_event,omitempty" json:"state_event,omitempty"`
+}
+
+func resourceGitlabProjectMilestoneCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	options, err := expandCreateMilestoneOptions(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] create gitlab milestone %q in project %s", *options.Title, project)
+
+	milestone, _, err := client.Milestones.CreateMilestone(project, options)
+	if err != nil {
+		return err
+	}
+
+	milestoneID := strconv.Itoa(milestone.ID)
+	d.SetId(buildTwoPartID(&project, &milestoneID))
+
+	if err := closeNewMilestone(d, client, fmt.Sprintf("projects/%s/milestones/%d", url.PathEscape(project), milestone.ID)); err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectMilestoneRead(d, meta)
+}
+
+func resourceGitlabProjectMilestoneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, milestoneID, err := parseMilestoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab milestone %d in project %s", milestoneID, project)
+
+	milestone, _, err := client.Milestones.GetMilestone(project, milestoneID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab milestone %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	setMilestoneState(d, milestone)
+	return nil
+}
+
+func resourceGitlabProjectMilestoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, milestoneID, err := parseMilestoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] update gitlab milestone %s", d.Id())
+
+	if err := updateMilestone(client, fmt.Sprintf("projects/%s/milestones/%d", url.PathEscape(project), milestoneID), expandUpdateMilestoneOptions(d)); err != nil {
+		return err
+	}
+
+	return resourceGitlabProjectMilestoneRead(d, meta)
+}
+
+func resourceGitlabProjectMilestoneDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, milestoneID, err := parseMilestoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Delete gitlab milestone %s", d.Id())
+
+	_, err = client.Milestones.DeleteMilestone(project, milestoneID)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+func expandCreateMilestoneOptions(d *schema.ResourceData) (*gitlab.CreateMilestoneOptions, error) {
+	options := &gitlab.CreateMilestoneOptions{
+		Title: gitlab.String(d.Get("title").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		options.Description = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("start_date"); ok {
+		startDate, err := parseMilestoneDate(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid start_date: %v", err)
+		}
+		options.StartDate = startDate
+	}
+	if v, ok := d.GetOk("due_date"); ok {
+		dueDate, err := parseMilestoneDate(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid due_date: %v", err)
+		}
+		options.DueDate = dueDate
+	}
+
+	return options, nil
+}
+
+// expandUpdateMilestoneOptions returns the options to update the changed attributes,
+// where an empty date removes the date from the milestone.
+func expandUpdateMilestoneOptions(d *schema.ResourceData) *updateMilestoneOptions {
+	options := &updateMilestoneOptions{}
+
+	if d.HasChange("title") {
+		options.Title = gitlab.String(d.Get("title").(string))
+	}
+	if d.HasChange("description") {
+		options.Description = gitlab.String(d.Get("description").(string))
+	}
+	if d.HasChange("start_date") {
+		options.StartDate = gitlab.String(d.Get("start_date").(string))
+	}
+	if d.HasChange("due_date") {
+		options.DueDate = gitlab.String(d.Get("due_date").(string))
+	}
+	if d.HasChange("state") {
+		options.StateEvent = gitlab.String(milestoneStateEvent(d.Get("state").(string)))
+	}
+
+	return options
+}
+
+func updateMilestone(client *gitlab.Client, path string, options *updateMilestoneOptions) error {
+	req, err := client.NewRequest(http.MethodPut, path, options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+// closeNewMilestone closes a milestone after its creation if it is configured as closed,
+// because milestones are always created active.
+func closeNewMilestone(d *schema.ResourceData, client *gitlab.Client, path string) error {
+	if d.Get("state").(string) != "closed" {
+		return nil
+	}
+
+	return updateMilestone(client, path, &updateMilestoneOptions{
+		StateEvent: gitlab.String(milestoneStateEvent("closed")),
+	})
+}
+
+func milestoneStateEvent(state string) string {
+	if state == "closed" {
+		return "close"
+	}
+	return "activate"
+}
+
+func setMilestoneState(d *schema.ResourceData, milestone *gitlab.Milestone) {
+	d.Set("milestone_id", milestone.ID)
+	d.Set("iid", milestone.IID)
+	d.Set("title", milestone.Title)
+	d.Set("description", milestone.Description)
+	d.Set("state", milestone.State)
+	if milestone.StartDate != nil {
+		d.Set("start_date", milestone.StartDate.String())
+	} else {
+		d.Set("start_date", "")
+	}
+	if milestone.DueDate != nil {
+		d.Set("due_date", milestone.DueDate.String())
+	} else {
+		d.Set("due_date", "")
+	}
+	if milestone.Expired != nil {
+		d.Set("expired", *milestone.Expired)
+	}
+}
+
+func parseMilestoneDate(date string) (*gitlab.ISOTime, error) {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, err
+	}
+
+	isoTime := gitlab.ISOTime(t)
+	return &isoTime, nil
+}
+
+// parseMilestoneID parses the `project:milestone_id` or `group:milestone_id` ID of a milestone.
+func parseMilestoneID(id string) (string, int, error) {
+	parent, rawMilestoneID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	milestoneID, err := strconv.Atoi(rawMilestoneID)
+	if err != nil {
+		return "", 0, fmt.Errorf("Unexpected milestone ID %q in ID %q: %w", rawMilestoneID, id, err)
+	}
+
+	return parent, milestoneID, nil
+}
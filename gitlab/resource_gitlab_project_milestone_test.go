@@ -0,0 +1,115 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectMilestone_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectMilestoneDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a milestone
+			{
+				Config: testAccGitlabMilestoneConfig("project", project.ID, "2021 Q1", `
+  start_date = "2021-01-01"
+  due_date   = "2021-03-31"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "title", "2021 Q1"),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "start_date", "2021-01-01"),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "due_date", "2021-03-31"),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "state", "active"),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "expired", "true"),
+					resource.TestCheckResourceAttrSet("gitlab_project_milestone.foo", "iid"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_milestone.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Rename and close the milestone, removing its start date
+			{
+				Config: testAccGitlabMilestoneConfig("project", project.ID, "2021 Q1 (done)", `
+  due_date = "2021-03-31"
+  state    = "closed"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "title", "2021 Q1 (done)"),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "start_date", ""),
+					resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "state", "closed"),
+				),
+			},
+			// Reopen the milestone
+			{
+				Config: testAccGitlabMilestoneConfig("project", project.ID, "2021 Q1 (done)", ""),
+				Check:  resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "state", "active"),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectMilestone_closed(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectMilestoneDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a closed milestone
+			{
+				Config: testAccGitlabMilestoneConfig("project", project.ID, "2020 Q4", `
+  state = "closed"`),
+				Check: resource.TestCheckResourceAttr("gitlab_project_milestone.foo", "state", "closed"),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectMilestoneDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_project_milestone" {
+				continue
+			}
+
+			project, milestoneID, err := parseMilestoneID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = client.Milestones.GetMilestone(project, milestoneID)
+			if err == nil {
+				return fmt.Errorf("milestone %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// testAccGitlabMilestoneConfig returns the configuration of a project or group milestone, given by parent.
+func testAccGitlabMilestoneConfig(parent string, parentID int, title, arguments string) string {
+	return fmt.Sprintf(`
+resource "gitlab_%s_milestone" "foo" {
+  %-11s = %d
+  title       = %q
+  description = "Terraform acceptance tests"
+%s
+}`, parent, parent, parentID, title, arguments)
+}
@@ -0,0 +1,188 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var (
+	// deployAccessLevelsElem is like allowedToElem of branch protections,
+	// but the access level can be configured instead of a user or a group.
+	deployAccessLevelsElem = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"access_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateValueFunc([]string{"developer", "maintainer"}),
+			},
+			"access_level_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+)
+
+// The protected environments API has no endpoint to edit a protected environment,
+// so every argument forces a new protected environment.
+func resourceGitlabProjectProtectedEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabProjectProtectedEnvironmentCreate,
+		Read:   resourceGitlabProjectProtectedEnvironmentRead,
+		Delete: resourceGitlabProjectProtectedEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"environment": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"deploy_access_levels": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     deployAccessLevelsElem,
+			},
+		},
+	}
+}
+
+func resourceGitlabProjectProtectedEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	environment := d.Get("environment").(string)
+
+	deployAccessLevels, err := expandEnvironmentAccessOptions(d.Get("deploy_access_levels").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] create gitlab protected environment %q in project %s", environment, project)
+
+	_, _, err = client.ProtectedEnvironments.ProtectRepositoryEnvironments(project, &gitlab.ProtectRepositoryEnvironmentsOptions{
+		Name:               gitlab.String(environment),
+		DeployAccessLevels: deployAccessLevels,
+	})
+	if err != nil {
+		return fmt.Errorf("error protecting environment %q on project %q: %v", environment, project, err)
+	}
+
+	d.SetId(buildTwoPartID(&project, &environment))
+
+	return resourceGitlabProjectProtectedEnvironmentRead(d, meta)
+}
+
+func resourceGitlabProjectProtectedEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, environment, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab protected environment %q in project %s", environment, project)
+
+	protectedEnvironment, _, err := client.ProtectedEnvironments.GetProtectedEnvironment(project, environment)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab protected environment %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	d.Set("environment", protectedEnvironment.Name)
+	if err := d.Set("deploy_access_levels", flattenEnvironmentAccessDescriptions(protectedEnvironment.DeployAccessLevels)); err != nil {
+		return fmt.Errorf("error setting deploy_access_levels: %v", err)
+	}
+
+	return nil
+}
+
+func resourceGitlabProjectProtectedEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, environment, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Delete gitlab protected environment %q in project %s", environment, project)
+
+	_, err = client.ProtectedEnvironments.UnprotectEnvironment(project, environment)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+func expandEnvironmentAccessOptions(deployAccessLevels []interface{}) ([]*gitlab.EnvironmentAccessOptions, error) {
+	result := make([]*gitlab.EnvironmentAccessOptions, 0, len(deployAccessLevels))
+	for _, v := range deployAccessLevels {
+		m := v.(map[string]interface{})
+		opt := &gitlab.EnvironmentAccessOptions{}
+		set := 0
+
+		if level := m["access_level"].(string); level != "" {
+			opt.AccessLevel = gitlab.AccessLevel(accessLevelID[level])
+			set++
+		}
+		if userID := m["user_id"].(int); userID != 0 {
+			opt.UserID = gitlab.Int(userID)
+			set++
+		}
+		if groupID := m["group_id"].(int); groupID != 0 {
+			opt.GroupID = gitlab.Int(groupID)
+			set++
+		}
+
+		if set != 1 {
+			return nil, errors.New("each deploy_access_levels block must set exactly one of access_level, user_id or group_id")
+		}
+
+		result = append(result, opt)
+	}
+	return result, nil
+}
+
+// flattenEnvironmentAccessDescriptions only sets the access level of the role based deploy access levels,
+// as GitLab also returns an access level for the ones of users and groups.
+func flattenEnvironmentAccessDescriptions(descriptions []*gitlab.EnvironmentAccessDescription) []stateBranchAccessDescription {
+	result := make([]stateBranchAccessDescription, 0, len(descriptions))
+
+	for _, description := range descriptions {
+		state := stateBranchAccessDescription{
+			AccessLevelDescription: description.AccessLevelDescription,
+			UserID:                 description.UserID,
+			GroupID:                description.GroupID,
+		}
+		if description.UserID == 0 && description.GroupID == 0 {
+			state.AccessLevel = accessLevel[description.AccessLevel]
+		}
+		result = append(result, state)
+	}
+
+	return result
+}
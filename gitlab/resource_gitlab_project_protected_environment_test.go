@@ -0,0 +1,134 @@
+package gitlab
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	. "github.com/onsi/gomega"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectProtectedEnvironment_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+
+	testAccCheckEE(t, client)
+
+	project := testAccCreateProject(t, client)
+	user := testAccCreateUsers(t, client, 1)[0]
+	testAccAddProjectMembers(t, client, project.ID, []*gitlab.User{user})
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectProtectedEnvironmentDestroy(client),
+		Steps: []resource.TestStep{
+			// Protect an environment for maintainers and a user
+			{
+				Config: testAccGitlabProjectProtectedEnvironmentConfig(project.ID, fmt.Sprintf(`
+  deploy_access_levels {
+    access_level = "maintainer"
+  }
+
+  deploy_access_levels {
+    user_id = %d
+  }
+`, user.ID)),
+				Check: testAccCheckGitlabProjectProtectedEnvironmentAccessLevels(client, project.ID, []*gitlab.EnvironmentAccessDescription{
+					{AccessLevel: gitlab.MaintainerPermissions},
+					{UserID: user.ID},
+				}),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_protected_environment.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Replace the deploy access levels
+			{
+				Config: testAccGitlabProjectProtectedEnvironmentConfig(project.ID, `
+  deploy_access_levels {
+    access_level = "developer"
+  }
+`),
+				Check: testAccCheckGitlabProjectProtectedEnvironmentAccessLevels(client, project.ID, []*gitlab.EnvironmentAccessDescription{
+					{AccessLevel: gitlab.DeveloperPermissions},
+				}),
+			},
+			// A deploy access level must set exactly one of access_level, user_id or group_id
+			{
+				Config: testAccGitlabProjectProtectedEnvironmentConfig(project.ID, fmt.Sprintf(`
+  deploy_access_levels {
+    access_level = "developer"
+    user_id      = %d
+  }
+`, user.ID)),
+				ExpectError: regexp.MustCompile("must set exactly one of access_level, user_id or group_id"),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectProtectedEnvironmentAccessLevels(client *gitlab.Client, pid interface{}, want []*gitlab.EnvironmentAccessDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return InterceptGomegaFailure(func() {
+			protectedEnvironment, _, err := client.ProtectedEnvironments.GetProtectedEnvironment(pid, "production")
+			Expect(err).To(BeNil())
+			Expect(protectedEnvironment.DeployAccessLevels).To(HaveLen(len(want)))
+
+			for _, w := range want {
+				var found bool
+				for _, got := range protectedEnvironment.DeployAccessLevels {
+					if w.UserID != 0 || w.GroupID != 0 {
+						found = found || (got.UserID == w.UserID && got.GroupID == w.GroupID)
+					} else {
+						found = found || (got.UserID == 0 && got.GroupID == 0 && got.AccessLevel == w.AccessLevel)
+					}
+				}
+				Expect(found).To(BeTrue(), "deploy access level %+v not found", *w)
+			}
+		})
+	}
+}
+
+func testAccCheckGitlabProjectProtectedEnvironmentDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_project_protected_environment" {
+				continue
+			}
+
+			project, environment, err := parseTwoPartID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = client.ProtectedEnvironments.GetProtectedEnvironment(project, environment)
+			if err == nil {
+				return fmt.Errorf("protected environment %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabProjectProtectedEnvironmentConfig(project int, deployAccessLevels string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project_environment" "foo" {
+  project = %d
+  name    = "production"
+}
+
+resource "gitlab_project_protected_environment" "foo" {
+  project     = gitlab_project_environment.foo.project
+  environment = gitlab_project_environment.foo.name
+%s
+}`, project, deployAccessLevels)
+}
@@ -0,0 +1,117 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabProjectPushRules() *schema.Resource {
+	s := projectPushRulesSchema()
+	s["project"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceGitlabProjectPushRulesCreate,
+		Read:   resourceGitlabProjectPushRulesRead,
+		Update: resourceGitlabProjectPushRulesUpdate,
+		Delete: resourceGitlabProjectPushRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceGitlabProjectPushRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	// Push rules which already exist are either managed by the push_rules block of a gitlab_project,
+	// or were created outside of Terraform. In both cases they must not be silently taken over.
+	pushRules, _, err := client.Projects.GetProjectPushRules(project)
+	if is404(err) {
+		log.Printf("[DEBUG] Failed to get push rules for project %q: %v", project, err)
+		return errors.New("Project push rules are not supported in your version of GitLab")
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to get push rules for project %q: %w", project, err)
+	}
+	if pushRules.ID != 0 {
+		return fmt.Errorf("Push rules already exist for project %q. They are either managed by the push_rules block of "+
+			"a gitlab_project resource, which conflicts with gitlab_project_push_rules, or were created outside of "+
+			"Terraform, in which case they must be imported first", project)
+	}
+
+	log.Printf("[DEBUG] create gitlab project push rules for project %q", project)
+
+	_, _, err = client.Projects.AddProjectPushRule(project, expandAddProjectPushRuleOptions(d, ""))
+	if err != nil {
+		return fmt.Errorf("Failed to add push rules for project %q: %w", project, err)
+	}
+
+	d.SetId(project)
+
+	return resourceGitlabProjectPushRulesRead(d, meta)
+}
+
+func resourceGitlabProjectPushRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	log.Printf("[DEBUG] read gitlab project push rules for project %q", d.Id())
+
+	pushRules, _, err := client.Projects.GetProjectPushRules(d.Id())
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project %q not found so removing push rules from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to get push rules for project %q: %w", d.Id(), err)
+	}
+	// The API returns null when the project has no push rules.
+	if pushRules.ID == 0 {
+		log.Printf("[DEBUG] gitlab project push rules for project %q not found so removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", d.Id())
+	for key, value := range flattenProjectPushRules(pushRules)[0] {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceGitlabProjectPushRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	log.Printf("[DEBUG] update gitlab project push rules for project %q", d.Id())
+
+	_, _, err := client.Projects.EditProjectPushRule(d.Id(), expandEditProjectPushRuleOptions(d, ""))
+	if err != nil {
+		return fmt.Errorf("Failed to edit push rules for project %q: %w", d.Id(), err)
+	}
+
+	return resourceGitlabProjectPushRulesRead(d, meta)
+}
+
+func resourceGitlabProjectPushRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	log.Printf("[DEBUG] Delete gitlab project push rules for project %q", d.Id())
+
+	_, err := client.Projects.DeleteProjectPushRule(d.Id())
+	if err != nil && !is404(err) {
+		return fmt.Errorf("Failed to delete push rules for project %q: %w", d.Id(), err)
+	}
+
+	return nil
+}
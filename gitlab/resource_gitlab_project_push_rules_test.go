@@ -0,0 +1,204 @@
+package gitlab
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	. "github.com/onsi/gomega"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectPushRules_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+
+	testAccCheckEE(t, client)
+
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectPushRulesDestroy(client, project.ID),
+		Steps: []resource.TestStep{
+			// Create push rules
+			{
+				Config: testAccGitlabProjectPushRulesConfig(project.ID, `
+author_email_regex = "foo_author"
+branch_name_regex = "foo_branch"
+commit_message_regex = "foo_commit"
+commit_message_negative_regex = "foo_not_commit"
+file_name_regex = "foo_file"
+commit_committer_check = true
+deny_delete_tag = true
+member_check = true
+prevent_secrets = true
+reject_unsigned_commits = true
+max_file_size = 123
+`),
+				Check: testAccCheckGitlabProjectPushRulesAttributes(client, project.ID, &gitlab.ProjectPushRules{
+					AuthorEmailRegex:           "foo_author",
+					BranchNameRegex:            "foo_branch",
+					CommitMessageRegex:         "foo_commit",
+					CommitMessageNegativeRegex: "foo_not_commit",
+					FileNameRegex:              "foo_file",
+					CommitCommitterCheck:       true,
+					DenyDeleteTag:              true,
+					MemberCheck:                true,
+					PreventSecrets:             true,
+					RejectUnsignedCommits:      true,
+					MaxFileSize:                123,
+				}),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_push_rules.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update some push rules and remove the others
+			{
+				Config: testAccGitlabProjectPushRulesConfig(project.ID, `
+branch_name_regex = "(feature|hotfix)\\/*"
+member_check = false
+max_file_size = 1234
+`),
+				Check: testAccCheckGitlabProjectPushRulesAttributes(client, project.ID, &gitlab.ProjectPushRules{
+					BranchNameRegex: `(feature|hotfix)\/*`,
+					MaxFileSize:     1234,
+				}),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectPushRules_conflict(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+
+	testAccCheckEE(t, client)
+
+	project := testAccCreateProject(t, client)
+
+	// Push rules which are managed elsewhere, for example by the push_rules block of gitlab_project.
+	_, _, err := client.Projects.AddProjectPushRule(project.ID, &gitlab.AddProjectPushRuleOptions{
+		AuthorEmailRegex: gitlab.String("foo_author"),
+	})
+	if err != nil {
+		t.Fatalf("could not add test project push rules: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccGitlabProjectPushRulesConfig(project.ID, `branch_name_regex = "foo_branch"`),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("Push rules already exist for project")),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectPushRules_withProject(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+
+	testAccCheckEE(t, client)
+
+	rInt := acctest.RandInt()
+
+	var project gitlab.Project
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			// Create the project first, without a push_rules block
+			{
+				Config: testAccGitlabProjectPushRulesWithProjectConfig(rInt, ""),
+				Check:  testAccCheckGitlabProjectExists("gitlab_project.foo", &project),
+			},
+			// Add the push rules resource afterwards. The push_rules block of the project
+			// is computed, so the project must neither overwrite nor plan to change them.
+			{
+				Config: testAccGitlabProjectPushRulesWithProjectConfig(rInt, `
+resource "gitlab_project_push_rules" "foo" {
+  project            = gitlab_project.foo.id
+  author_email_regex = "foo_author"
+  max_file_size      = 123
+}`),
+				Check: testAccCheckGitlabProjectPushRulesAttributes(client, fmt.Sprintf("%d", project.ID), &gitlab.ProjectPushRules{
+					AuthorEmailRegex: "foo_author",
+					MaxFileSize:      123,
+				}),
+			},
+			// The refreshed project does not plan to change the push rules
+			{
+				Config: testAccGitlabProjectPushRulesWithProjectConfig(rInt, `
+resource "gitlab_project_push_rules" "foo" {
+  project            = gitlab_project.foo.id
+  author_email_regex = "foo_author"
+  max_file_size      = 123
+}`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectPushRulesAttributes(client *gitlab.Client, pid interface{}, want *gitlab.ProjectPushRules) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return InterceptGomegaFailure(func() {
+			got, _, err := client.Projects.GetProjectPushRules(pid)
+			Expect(err).To(BeNil())
+
+			Expect(got.AuthorEmailRegex).To(Equal(want.AuthorEmailRegex), "author_email_regex")
+			Expect(got.BranchNameRegex).To(Equal(want.BranchNameRegex), "branch_name_regex")
+			Expect(got.CommitMessageRegex).To(Equal(want.CommitMessageRegex), "commit_message_regex")
+			Expect(got.CommitMessageNegativeRegex).To(Equal(want.CommitMessageNegativeRegex), "commit_message_negative_regex")
+			Expect(got.FileNameRegex).To(Equal(want.FileNameRegex), "file_name_regex")
+			Expect(got.CommitCommitterCheck).To(Equal(want.CommitCommitterCheck), "commit_committer_check")
+			Expect(got.DenyDeleteTag).To(Equal(want.DenyDeleteTag), "deny_delete_tag")
+			Expect(got.MemberCheck).To(Equal(want.MemberCheck), "member_check")
+			Expect(got.PreventSecrets).To(Equal(want.PreventSecrets), "prevent_secrets")
+			Expect(got.RejectUnsignedCommits).To(Equal(want.RejectUnsignedCommits), "reject_unsigned_commits")
+			Expect(got.MaxFileSize).To(Equal(want.MaxFileSize), "max_file_size")
+		})
+	}
+}
+
+func testAccCheckGitlabProjectPushRulesDestroy(client *gitlab.Client, pid interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return InterceptGomegaFailure(func() {
+			pushRules, _, err := client.Projects.GetProjectPushRules(pid)
+			Expect(err).To(BeNil())
+			Expect(pushRules.ID).To(BeZero())
+		})
+	}
+}
+
+func testAccGitlabProjectPushRulesConfig(project int, pushRules string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project_push_rules" "foo" {
+  project = %d
+%s
+}`, project, pushRules)
+}
+
+func testAccGitlabProjectPushRulesWithProjectConfig(rInt int, pushRules string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%d"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+%s`, rInt, pushRules)
+}
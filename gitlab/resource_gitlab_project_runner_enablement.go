@@ -0,0 +1,139 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabProjectRunnerEnablement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabProjectRunnerEnablementCreate,
+		Read:   resourceGitlabProjectRunnerEnablementRead,
+		Delete: resourceGitlabProjectRunnerEnablementDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"runner_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+var errProjectRunnerNotFound = errors.New("runner not enabled on project")
+
+func resourceGitlabProjectRunnerEnablementCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	runnerID := d.Get("runner_id").(int)
+
+	log.Printf("[DEBUG] enable gitlab runner %d on project %s", runnerID, project)
+
+	_, _, err := client.Runners.EnableProjectRunner(project, &gitlab.EnableProjectRunnerOptions{
+		RunnerID: runnerID,
+	})
+	if err != nil {
+		return err
+	}
+
+	runnerIDString := strconv.Itoa(runnerID)
+	d.SetId(buildTwoPartID(&project, &runnerIDString))
+
+	return resourceGitlabProjectRunnerEnablementRead(d, meta)
+}
+
+func resourceGitlabProjectRunnerEnablementRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, runnerID, err := parseProjectRunnerEnablementID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab runner %d on project %s", runnerID, project)
+
+	if _, err := findProjectRunner(client, project, runnerID); err != nil {
+		if is404(err) || errors.Is(err, errProjectRunnerNotFound) {
+			log.Printf("[DEBUG] gitlab runner enablement %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	d.Set("runner_id", runnerID)
+	return nil
+}
+
+func resourceGitlabProjectRunnerEnablementDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, runnerID, err := parseProjectRunnerEnablementID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Disable gitlab runner %d on project %s", runnerID, project)
+
+	_, err = client.Runners.DisableProjectRunner(project, runnerID)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+// findProjectRunner looks the runner up in the list of project runners enabled on the project.
+func findProjectRunner(client *gitlab.Client, project string, runnerID int) (*gitlab.Runner, error) {
+	options := &gitlab.ListProjectRunnersOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		Type: gitlab.String("project_type"),
+	}
+
+	for options.Page != 0 {
+		runners, resp, err := client.Runners.ListProjectRunners(project, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, runner := range runners {
+			if runner.ID == runnerID {
+				return runner, nil
+			}
+		}
+
+		options.Page = resp.NextPage
+	}
+
+	return nil, errProjectRunnerNotFound
+}
+
+func parseProjectRunnerEnablementID(id string) (string, int, error) {
+	project, rawRunnerID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	runnerID, err := strconv.Atoi(rawRunnerID)
+	if err != nil {
+		return "", 0, fmt.Errorf("Unexpected runner ID %q in ID %q: %w", rawRunnerID, id, err)
+	}
+
+	return project, runnerID, nil
+}
@@ -0,0 +1,76 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectRunnerEnablement_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+	otherProject := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabProjectRunnerEnablementDestroy(client),
+		Steps: []resource.TestStep{
+			// Enable the runner of a project on another project
+			{
+				Config: testAccGitlabProjectRunnerEnablementConfig(project, otherProject),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_runner_enablement.foo", "project", fmt.Sprintf("%d", otherProject.ID)),
+					resource.TestCheckResourceAttrPair("gitlab_project_runner_enablement.foo", "runner_id", "gitlab_runner.foo", "id"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_runner_enablement.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectRunnerEnablementDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_project_runner_enablement" {
+				continue
+			}
+
+			project, runnerID, err := parseProjectRunnerEnablementID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = findProjectRunner(client, project, runnerID)
+			if err == nil {
+				return fmt.Errorf("runner enablement %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) && err != errProjectRunnerNotFound {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabProjectRunnerEnablementConfig(project, otherProject *gitlab.Project) string {
+	return fmt.Sprintf(`
+resource "gitlab_runner" "foo" {
+  registration_token = %q
+  description        = "shared between projects"
+}
+
+resource "gitlab_project_runner_enablement" "foo" {
+  project   = %d
+  runner_id = gitlab_runner.foo.id
+}`, project.RunnersToken, otherProject.ID)
+}
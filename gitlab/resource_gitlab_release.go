@@ -0,0 +1,345 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var releaseLinkTypes = []string{"other", "runbook", "image", "package"}
+
+func resourceGitlabRelease() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabReleaseCreate,
+		Read:   resourceGitlabReleaseRead,
+		Update: resourceGitlabReleaseUpdate,
+		Delete: resourceGitlabReleaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tag_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"milestones": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"released_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: expiresAtSuppressFunc,
+			},
+			"links": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateURLFunc,
+						},
+						"link_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "other",
+							ValidateFunc: validateValueFunc(releaseLinkTypes),
+						},
+						"link_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"direct_asset_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// release extends gitlab.Release with the attributes not supported by go-gitlab yet.
+type release struct {
+	gitlab.Release
+	Milestones []*gitlab.Milestone `json:"milestones"`
+}
+
+// updateReleaseOptions replaces gitlab.UpdateReleaseOptions, which cannot remove all milestones of a release.
+type updateReleaseOptions struct {
+	Name        *string    `url:"name" json:"name"`
+	Description *string    `url:"description" json:"description"`
+	Milestones  *[]string  `url:"milestones,omitempty" json:"milestones,omitempty"`
+	ReleasedAt  *time.Time `url:"released_at,omitempty" json:"released_at,omitempty"`
+}
+
+func resourceGitlabReleaseCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	tagName := d.Get("tag_name").(string)
+	options := &gitlab.CreateReleaseOptions{
+		TagName:     gitlab.String(tagName),
+		Description: gitlab.String(d.Get("description").(string)),
+		Milestones:  *stringSetToStringSlice(d.Get("milestones").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		options.Name = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("released_at"); ok {
+		releasedAt, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Invalid released_at date: %v", err)
+		}
+		options.ReleasedAt = &releasedAt
+	}
+
+	log.Printf("[DEBUG] create gitlab release %q in project %s", tagName, project)
+
+	_, _, err := client.Releases.CreateRelease(project, options)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&project, &tagName))
+
+	// The links are added afterwards, as the assets of the create release endpoint have no link type.
+	if err := updateReleaseLinks(client, project, tagName, nil, d.Get("links").(*schema.Set).List()); err != nil {
+		return err
+	}
+
+	return resourceGitlabReleaseRead(d, meta)
+}
+
+func resourceGitlabReleaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, tagName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab release %q in project %s", tagName, project)
+
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/releases/%s", url.PathEscape(project), url.PathEscape(tagName)), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	r := new(release)
+	if _, err := client.Do(req, r); err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab release %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	d.Set("tag_name", r.TagName)
+	d.Set("name", r.Name)
+	d.Set("description", r.Description)
+
+	milestones := make([]string, 0, len(r.Milestones))
+	for _, milestone := range r.Milestones {
+		milestones = append(milestones, milestone.Title)
+	}
+	if err := d.Set("milestones", milestones); err != nil {
+		return fmt.Errorf("error setting milestones: %v", err)
+	}
+
+	if err := d.Set("links", flattenReleaseLinks(r.Assets.Links)); err != nil {
+		return fmt.Errorf("error setting links: %v", err)
+	}
+
+	if r.ReleasedAt != nil {
+		d.Set("released_at", r.ReleasedAt.Format(time.RFC3339))
+	}
+	if r.CreatedAt != nil {
+		d.Set("created_at", r.CreatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceGitlabReleaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, tagName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("name", "description", "milestones", "released_at") {
+		options := &updateReleaseOptions{
+			Name:        gitlab.String(d.Get("name").(string)),
+			Description: gitlab.String(d.Get("description").(string)),
+			Milestones:  stringSetToStringSlice(d.Get("milestones").(*schema.Set)),
+		}
+
+		if d.HasChange("released_at") {
+			releasedAt, err := time.Parse(time.RFC3339, d.Get("released_at").(string))
+			if err != nil {
+				return fmt.Errorf("Invalid released_at date: %v", err)
+			}
+			options.ReleasedAt = &releasedAt
+		}
+
+		log.Printf("[DEBUG] update gitlab release %s", d.Id())
+
+		req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("projects/%s/releases/%s", url.PathEscape(project), url.PathEscape(tagName)), options, nil)
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.Do(req, nil); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("links") {
+		oldLinks, newLinks := d.GetChange("links")
+		if err := updateReleaseLinks(client, project, tagName, oldLinks.(*schema.Set).List(), newLinks.(*schema.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	return resourceGitlabReleaseRead(d, meta)
+}
+
+func resourceGitlabReleaseDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, tagName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Delete gitlab release %s", d.Id())
+
+	// Deleting a release keeps its tag.
+	_, _, err = client.Releases.DeleteRelease(project, url.PathEscape(tagName))
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+// updateReleaseLinks matches the old and the new links by name, as the names of the links of a release are unique.
+// It deletes the links which are gone before it updates the remaining ones and adds the new ones,
+// so that a URL can move to a link with another name.
+func updateReleaseLinks(client *gitlab.Client, project, tagName string, oldLinks, newLinks []interface{}) error {
+	escapedTagName := url.PathEscape(tagName)
+
+	newNames := make(map[string]bool, len(newLinks))
+	for _, v := range newLinks {
+		newNames[v.(map[string]interface{})["name"].(string)] = true
+	}
+
+	oldIDs := make(map[string]int, len(oldLinks))
+	for _, v := range oldLinks {
+		link := v.(map[string]interface{})
+		oldIDs[link["name"].(string)] = link["link_id"].(int)
+	}
+
+	for name, id := range oldIDs {
+		if newNames[name] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Delete link %q of gitlab release %q in project %s", name, tagName, project)
+
+		if _, _, err := client.ReleaseLinks.DeleteReleaseLink(project, escapedTagName, id); err != nil && !is404(err) {
+			return err
+		}
+	}
+
+	for _, v := range newLinks {
+		link := v.(map[string]interface{})
+		name := link["name"].(string)
+		linkURL := link["url"].(string)
+		linkType := gitlab.LinkTypeValue(link["link_type"].(string))
+
+		if id, ok := oldIDs[name]; ok {
+			log.Printf("[DEBUG] update link %q of gitlab release %q in project %s", name, tagName, project)
+
+			_, _, err := client.ReleaseLinks.UpdateReleaseLink(project, escapedTagName, id, &gitlab.UpdateReleaseLinkOptions{
+				URL:      gitlab.String(linkURL),
+				LinkType: gitlab.LinkType(linkType),
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Printf("[DEBUG] create link %q of gitlab release %q in project %s", name, tagName, project)
+
+		_, _, err := client.ReleaseLinks.CreateReleaseLink(project, escapedTagName, &gitlab.CreateReleaseLinkOptions{
+			Name:     gitlab.String(name),
+			URL:      gitlab.String(linkURL),
+			LinkType: gitlab.LinkType(linkType),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flattenReleaseLinks(links []*gitlab.ReleaseLink) []interface{} {
+	result := make([]interface{}, 0, len(links))
+
+	for _, link := range links {
+		linkType := string(link.LinkType)
+		if linkType == "" {
+			linkType = "other"
+		}
+		result = append(result, map[string]interface{}{
+			"name":             link.Name,
+			"url":              link.URL,
+			"link_type":        linkType,
+			"link_id":          link.ID,
+			"direct_asset_url": link.DirectAssetURL,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,123 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabRelease_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	milestone, _, err := client.Milestones.CreateMilestone(project.ID, &gitlab.CreateMilestoneOptions{
+		Title: gitlab.String("1.0"),
+	})
+	if err != nil {
+		t.Fatalf("failed to create milestone: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabReleaseDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a release with a milestone and a link
+			{
+				Config: testAccGitlabReleaseConfig(project, `
+  milestones  = [%q]
+  released_at = "2021-01-01T00:00:00Z"
+
+  links {
+    name = "binary"
+    url  = "https://example.com/binary"
+  }`, milestone.Title),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_release.foo", "name", "Version 1.0.0"),
+					resource.TestCheckResourceAttr("gitlab_release.foo", "description", "The first release"),
+					resource.TestCheckResourceAttr("gitlab_release.foo", "milestones.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_release.foo", "released_at", "2021-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("gitlab_release.foo", "links.#", "1"),
+					resource.TestCheckResourceAttrSet("gitlab_release.foo", "created_at"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_release.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove the milestone, change the link and add another one
+			{
+				Config: testAccGitlabReleaseConfig(project, `
+  released_at = "2021-01-01T00:00:00Z"
+
+  links {
+    name      = "binary"
+    url       = "https://example.com/binary-v2"
+    link_type = "package"
+  }
+
+  links {
+    name      = "runbook"
+    url       = "https://example.com/runbook"
+    link_type = "runbook"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_release.foo", "milestones.#", "0"),
+					resource.TestCheckResourceAttr("gitlab_release.foo", "links.#", "2"),
+				),
+			},
+			// Remove all links
+			{
+				Config: testAccGitlabReleaseConfig(project, ""),
+				Check:  resource.TestCheckResourceAttr("gitlab_release.foo", "links.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabReleaseDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_release" {
+				continue
+			}
+
+			project, tagName, err := parseTwoPartID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = client.Releases.GetRelease(project, tagName)
+			if err == nil {
+				return fmt.Errorf("release %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabReleaseConfig(project *gitlab.Project, arguments string, args ...interface{}) string {
+	return fmt.Sprintf(`
+resource "gitlab_tag" "foo" {
+  project = %d
+  name    = "v1.0.0"
+  ref     = %q
+}
+
+resource "gitlab_release" "foo" {
+  project     = %d
+  tag_name    = gitlab_tag.foo.name
+  name        = "Version 1.0.0"
+  description = "The first release"
+%s
+}`, project.ID, project.DefaultBranch, project.ID, fmt.Sprintf(arguments, args...))
+}
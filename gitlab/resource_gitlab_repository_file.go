@@ -0,0 +1,242 @@
+package gitlab
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabRepositoryFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabRepositoryFileCreate,
+		Read:   resourceGitlabRepositoryFileRead,
+		Update: resourceGitlabRepositoryFileUpdate,
+		Delete: resourceGitlabRepositoryFileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"branch": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"file_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"encoding": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "text",
+				ValidateFunc: validateValueFunc([]string{"text", "base64"}),
+			},
+			"author_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"author_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"blob_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitlabRepositoryFileCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	branch := d.Get("branch").(string)
+	filePath := d.Get("file_path").(string)
+
+	options := &gitlab.CreateFileOptions{
+		Branch:        gitlab.String(branch),
+		Encoding:      gitlab.String(d.Get("encoding").(string)),
+		Content:       gitlab.String(d.Get("content").(string)),
+		CommitMessage: gitlab.String(repositoryFileCommitMessage(d, "Create")),
+	}
+	if v, ok := d.GetOk("author_name"); ok {
+		options.AuthorName = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("author_email"); ok {
+		options.AuthorEmail = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab repository file %q on branch %q in project %s", filePath, branch, project)
+
+	_, _, err := client.RepositoryFiles.CreateFile(project, filePath, options)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildRepositoryFileID(project, branch, filePath))
+
+	return resourceGitlabRepositoryFileRead(d, meta)
+}
+
+func resourceGitlabRepositoryFileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, branch, filePath, err := parseRepositoryFileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab repository file %q on branch %q in project %s", filePath, branch, project)
+
+	file, _, err := client.RepositoryFiles.GetFile(project, filePath, &gitlab.GetFileOptions{
+		Ref: gitlab.String(branch),
+	})
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab repository file %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// The API always returns the content base64 encoded.
+	content, err := base64.StdEncoding.DecodeString(file.Content)
+	if err != nil {
+		return fmt.Errorf("Failed to decode the content of repository file %s: %w", d.Id(), err)
+	}
+
+	encoding := d.Get("encoding").(string)
+	if encoding == "" {
+		// The encoding is not known on import.
+		encoding = "text"
+	}
+
+	d.Set("project", project)
+	d.Set("branch", branch)
+	d.Set("file_path", file.FilePath)
+	d.Set("encoding", encoding)
+	if encoding == "base64" {
+		d.Set("content", base64.StdEncoding.EncodeToString(content))
+	} else {
+		d.Set("content", string(content))
+	}
+	d.Set("last_commit_id", file.LastCommitID)
+	d.Set("blob_id", file.BlobID)
+	d.Set("content_sha256", file.SHA256)
+	return nil
+}
+
+func resourceGitlabRepositoryFileUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, branch, filePath, err := parseRepositoryFileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Only the content and its encoding are stored in the repository, the other arguments only apply to the next commit.
+	if !d.HasChanges("content", "encoding") {
+		return resourceGitlabRepositoryFileRead(d, meta)
+	}
+
+	options := &gitlab.UpdateFileOptions{
+		Branch:        gitlab.String(branch),
+		Encoding:      gitlab.String(d.Get("encoding").(string)),
+		Content:       gitlab.String(d.Get("content").(string)),
+		CommitMessage: gitlab.String(repositoryFileCommitMessage(d, "Update")),
+		LastCommitID:  gitlab.String(d.Get("last_commit_id").(string)),
+	}
+	if v, ok := d.GetOk("author_name"); ok {
+		options.AuthorName = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("author_email"); ok {
+		options.AuthorEmail = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] update gitlab repository file %s", d.Id())
+
+	_, _, err = client.RepositoryFiles.UpdateFile(project, filePath, options)
+	if err != nil {
+		return err
+	}
+
+	return resourceGitlabRepositoryFileRead(d, meta)
+}
+
+func resourceGitlabRepositoryFileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, branch, filePath, err := parseRepositoryFileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	options := &gitlab.DeleteFileOptions{
+		Branch:        gitlab.String(branch),
+		CommitMessage: gitlab.String(repositoryFileCommitMessage(d, "Delete")),
+	}
+	if v, ok := d.GetOk("author_name"); ok {
+		options.AuthorName = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("author_email"); ok {
+		options.AuthorEmail = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Delete gitlab repository file %s", d.Id())
+
+	_, err = client.RepositoryFiles.DeleteFile(project, filePath, options)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+// repositoryFileCommitMessage returns the configured commit message,
+// or a message made up of the given action and the file path.
+func repositoryFileCommitMessage(d *schema.ResourceData, action string) string {
+	if v, ok := d.GetOk("commit_message"); ok {
+		return v.(string)
+	}
+	return fmt.Sprintf("%s %s", action, d.Get("file_path").(string))
+}
+
+// buildRepositoryFileID returns the `project:branch:file_path` ID of a repository file.
+// The file path is last, because it is the only part which may contain a colon.
+func buildRepositoryFileID(project, branch, filePath string) string {
+	return strings.Join([]string{project, branch, filePath}, ":")
+}
+
+func parseRepositoryFileID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Unexpected ID format (%q). Expected project:branch:file_path", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,125 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabRepositoryFile_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabRepositoryFileDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a file
+			{
+				Config: testAccGitlabRepositoryFileConfig(project, "text", "hello world\n"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_repository_file.foo", "content", "hello world\n"),
+					resource.TestCheckResourceAttrSet("gitlab_repository_file.foo", "last_commit_id"),
+					resource.TestCheckResourceAttrSet("gitlab_repository_file.foo", "blob_id"),
+					resource.TestCheckResourceAttrSet("gitlab_repository_file.foo", "content_sha256"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_repository_file.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"author_name", "author_email", "commit_message"},
+			},
+			// Update the content
+			{
+				Config: testAccGitlabRepositoryFileConfig(project, "text", "hello terraform\n"),
+				Check:  resource.TestCheckResourceAttr("gitlab_repository_file.foo", "content", "hello terraform\n"),
+			},
+			// Restore the content after it has been changed outside of terraform
+			{
+				PreConfig: func() {
+					_, _, err := client.RepositoryFiles.UpdateFile(project.ID, "test/file.txt", &gitlab.UpdateFileOptions{
+						Branch:        gitlab.String(project.DefaultBranch),
+						Content:       gitlab.String("changed by hand\n"),
+						CommitMessage: gitlab.String("Change file by hand"),
+					})
+					if err != nil {
+						t.Fatalf("failed to update file: %v", err)
+					}
+				},
+				Config: testAccGitlabRepositoryFileConfig(project, "text", "hello terraform\n"),
+				Check:  testAccCheckGitlabRepositoryFileContent(client, project, "hello terraform\n"),
+			},
+			// Use base64 encoded content
+			{
+				Config: testAccGitlabRepositoryFileConfig(project, "base64", "aGVsbG8gYmFzZTY0Cg=="),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_repository_file.foo", "content", "aGVsbG8gYmFzZTY0Cg=="),
+					testAccCheckGitlabRepositoryFileContent(client, project, "hello base64\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabRepositoryFileContent(client *gitlab.Client, project *gitlab.Project, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		content, _, err := client.RepositoryFiles.GetRawFile(project.ID, "test/file.txt", &gitlab.GetRawFileOptions{
+			Ref: gitlab.String(project.DefaultBranch),
+		})
+		if err != nil {
+			return err
+		}
+
+		if string(content) != expected {
+			return fmt.Errorf("unexpected file content %q, expected %q", content, expected)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabRepositoryFileDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_repository_file" {
+				continue
+			}
+
+			project, branch, filePath, err := parseRepositoryFileID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = client.RepositoryFiles.GetFile(project, filePath, &gitlab.GetFileOptions{
+				Ref: gitlab.String(branch),
+			})
+			if err == nil {
+				return fmt.Errorf("repository file %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabRepositoryFileConfig(project *gitlab.Project, encoding, content string) string {
+	return fmt.Sprintf(`
+resource "gitlab_repository_file" "foo" {
+  project        = %d
+  branch         = %q
+  file_path      = "test/file.txt"
+  encoding       = %q
+  content        = %q
+  author_name    = "Terraform"
+  author_email   = "terraform@example.com"
+  commit_message = "Managed by terraform"
+}`, project.ID, project.DefaultBranch, encoding, content)
+}
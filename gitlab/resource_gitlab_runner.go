@@ -0,0 +1,231 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabRunner() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabRunnerCreate,
+		Read:   resourceGitlabRunnerRead,
+		Update: resourceGitlabRunnerUpdate,
+		Delete: resourceGitlabRunnerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"registration_token": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				// The registration token cannot be read from a runner, so it is unknown after an import.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != "" && old == ""
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag_list": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"run_untagged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"locked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"access_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "not_protected",
+				ValidateFunc: validateValueFunc([]string{"not_protected", "ref_protected"}),
+			},
+			"maximum_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(600),
+			},
+			"authentication_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// registerRunnerOptions replaces gitlab.RegisterNewRunnerOptions, which does not support the access level.
+type registerRunnerOptions struct {
+	Token          *string  `url:"token" json:"token"`
+	Description    *string  `url:"description,omitempty" json:"description,omitempty"`
+	Locked         *bool    `url:"locked,omitempty" json:"locked,omitempty"`
+	RunUntagged    *bool    `url:"run_untagged,omitempty" json:"run_untagged,omitempty"`
+	TagList        []string `url:"tag_list[],omitempty" json:"tag_list,omitempty"`
+	AccessLevel    *string  `url:"access_level,omitempty" json:"access_level,omitempty"`
+	MaximumTimeout *int     `url:"maximum_timeout,omitempty" json:"maximum_timeout,omitempty"`
+}
+
+// updateRunnerOptions replaces gitlab.UpdateRunnerDetailsOptions, which cannot remove all tags of a runner.
+type updateRunnerOptions struct {
+	Description    *string   `url:"description,omitempty" json:"description,omitempty"`
+	TagList        *[]string `url:"tag_list[],omitempty" json:"tag_list,omitempty"`
+	RunUntagged    *bool     `url:"run_untagged,omitempty" json:"run_untagged,omitempty"`
+	Locked         *bool     `url:"locked,omitempty" json:"locked,omitempty"`
+	AccessLevel    *string   `url:"access_level,omitempty" json:"access_level,omitempty"`
+	MaximumTimeout *int      `url:"maximum_timeout,omitempty" json:"maximum_timeout,omitempty"`
+}
+
+func resourceGitlabRunnerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	options := &registerRunnerOptions{
+		Token:       gitlab.String(d.Get("registration_token").(string)),
+		Locked:      gitlab.Bool(d.Get("locked").(bool)),
+		RunUntagged: gitlab.Bool(d.Get("run_untagged").(bool)),
+		TagList:     *stringSetToStringSlice(d.Get("tag_list").(*schema.Set)),
+		AccessLevel: gitlab.String(d.Get("access_level").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		options.Description = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("maximum_timeout"); ok {
+		options.MaximumTimeout = gitlab.Int(v.(int))
+	}
+
+	log.Printf("[DEBUG] register gitlab runner %q", d.Get("description").(string))
+
+	req, err := client.NewRequest(http.MethodPost, "runners", options, nil)
+	if err != nil {
+		return err
+	}
+
+	runner := new(gitlab.Runner)
+	if _, err := client.Do(req, runner); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(runner.ID))
+	d.Set("authentication_token", runner.Token)
+
+	return resourceGitlabRunnerRead(d, meta)
+}
+
+func resourceGitlabRunnerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	runnerID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Unexpected runner ID %q: %w", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] read gitlab runner %d", runnerID)
+
+	runner, _, err := client.Runners.GetRunnerDetails(runnerID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab runner %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("description", runner.Description)
+	if err := d.Set("tag_list", runner.TagList); err != nil {
+		return fmt.Errorf("error setting tag_list: %v", err)
+	}
+	d.Set("run_untagged", runner.RunUntagged)
+	d.Set("locked", runner.Locked)
+	d.Set("access_level", runner.AccessLevel)
+	d.Set("maximum_timeout", runner.MaximumTimeout)
+	d.Set("status", runner.Status)
+	// Only older GitLab versions return the authentication token, and only to administrators.
+	if runner.Token != "" {
+		d.Set("authentication_token", runner.Token)
+	}
+	return nil
+}
+
+func resourceGitlabRunnerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	options := &updateRunnerOptions{}
+
+	if d.HasChange("description") {
+		options.Description = gitlab.String(d.Get("description").(string))
+	}
+	if d.HasChange("tag_list") {
+		options.TagList = stringSetToStringSlice(d.Get("tag_list").(*schema.Set))
+	}
+	if d.HasChange("run_untagged") {
+		options.RunUntagged = gitlab.Bool(d.Get("run_untagged").(bool))
+	}
+	if d.HasChange("locked") {
+		options.Locked = gitlab.Bool(d.Get("locked").(bool))
+	}
+	if d.HasChange("access_level") {
+		options.AccessLevel = gitlab.String(d.Get("access_level").(string))
+	}
+	if d.HasChange("maximum_timeout") {
+		options.MaximumTimeout = gitlab.Int(d.Get("maximum_timeout").(int))
+	}
+
+	log.Printf("[DEBUG] update gitlab runner %s", d.Id())
+
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("runners/%s", d.Id()), options, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		return err
+	}
+
+	return resourceGitlabRunnerRead(d, meta)
+}
+
+func resourceGitlabRunnerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] Delete gitlab runner %s", d.Id())
+
+	var err error
+	if token, ok := d.GetOk("authentication_token"); ok {
+		// Deleting the runner with its authentication token does not require administrator access.
+		_, err = client.Runners.DeleteRegisteredRunner(&gitlab.DeleteRegisteredRunnerOptions{
+			Token: gitlab.String(token.(string)),
+		})
+	} else {
+		var runnerID int
+		runnerID, err = strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Unexpected runner ID %q: %w", d.Id(), err)
+		}
+		_, err = client.Runners.RemoveRunner(runnerID)
+	}
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
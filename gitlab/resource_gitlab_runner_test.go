@@ -0,0 +1,92 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabRunner_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabRunnerDestroy(client),
+		Steps: []resource.TestStep{
+			// Register a runner
+			{
+				Config: testAccGitlabRunnerConfig(project, "docker", `["docker", "linux"]`, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_runner.foo", "description", "docker"),
+					resource.TestCheckResourceAttr("gitlab_runner.foo", "tag_list.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_runner.foo", "run_untagged", "false"),
+					resource.TestCheckResourceAttr("gitlab_runner.foo", "locked", "true"),
+					resource.TestCheckResourceAttr("gitlab_runner.foo", "access_level", "ref_protected"),
+					resource.TestCheckResourceAttr("gitlab_runner.foo", "maximum_timeout", "3600"),
+					resource.TestCheckResourceAttrSet("gitlab_runner.foo", "authentication_token"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_runner.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"registration_token", "authentication_token", "status"},
+			},
+			// Update the runner, removing all tags
+			{
+				Config: testAccGitlabRunnerConfig(project, "shell", `[]`, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_runner.foo", "description", "shell"),
+					resource.TestCheckResourceAttr("gitlab_runner.foo", "tag_list.#", "0"),
+					resource.TestCheckResourceAttr("gitlab_runner.foo", "run_untagged", "true"),
+					resource.TestCheckResourceAttr("gitlab_runner.foo", "locked", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabRunnerDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_runner" {
+				continue
+			}
+
+			runnerID, err := strconv.Atoi(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = client.Runners.GetRunnerDetails(runnerID)
+			if err == nil {
+				return fmt.Errorf("runner %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabRunnerConfig(project *gitlab.Project, description, tagList string, tagged bool) string {
+	return fmt.Sprintf(`
+resource "gitlab_runner" "foo" {
+  registration_token = %q
+  description        = %q
+  tag_list           = %s
+  run_untagged       = %t
+  locked             = %t
+  access_level       = "ref_protected"
+  maximum_timeout    = 3600
+}`, project.RunnersToken, description, tagList, !tagged, tagged)
+}
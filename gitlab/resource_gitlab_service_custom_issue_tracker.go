@@ -0,0 +1,108 @@
+package gitlab
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabServiceCustomIssueTracker() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabServiceCustomIssueTrackerCreate,
+		Read:   resourceGitlabServiceCustomIssueTrackerRead,
+		Update: resourceGitlabServiceCustomIssueTrackerCreate,
+		Delete: resourceGitlabServiceCustomIssueTrackerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"issues_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"new_issue_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitlabServiceCustomIssueTrackerSetToState(d *schema.ResourceData, service *gitlab.CustomIssueTrackerService) {
+	d.Set("project_url", service.Properties.ProjectURL)
+	d.Set("issues_url", service.Properties.IssuesURL)
+	d.Set("new_issue_url", service.Properties.NewIssueURL)
+	d.Set("active", service.Active)
+}
+
+func resourceGitlabServiceCustomIssueTrackerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.SetCustomIssueTrackerServiceOptions{
+		ProjectURL:  gitlab.String(d.Get("project_url").(string)),
+		IssuesURL:   gitlab.String(d.Get("issues_url").(string)),
+		NewIssueURL: gitlab.String(d.Get("new_issue_url").(string)),
+	}
+
+	log.Printf("[DEBUG] create gitlab custom issue tracker service for project %s", project)
+
+	_, err := client.Services.SetCustomIssueTrackerService(project, options)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(project)
+
+	return resourceGitlabServiceCustomIssueTrackerRead(d, meta)
+}
+
+func resourceGitlabServiceCustomIssueTrackerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab custom issue tracker service for project %s", project)
+
+	service, _, err := client.Services.GetCustomIssueTrackerService(project)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab custom issue tracker service for project %s not found so removing from state", project)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	resourceGitlabServiceCustomIssueTrackerSetToState(d, service)
+	return nil
+}
+
+func resourceGitlabServiceCustomIssueTrackerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab custom issue tracker service for project %s", project)
+
+	_, err := client.Services.DeleteCustomIssueTrackerService(project)
+	return err
+}
@@ -0,0 +1,81 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabServiceCustomIssueTracker_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabServiceCustomIssueTrackerDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a custom issue tracker service
+			{
+				Config: testAccGitlabServiceCustomIssueTrackerConfig(project.ID, "https://tracker.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_custom_issue_tracker.tracker", "project_url", "https://tracker.example.com/projects/foo"),
+					resource.TestCheckResourceAttr("gitlab_service_custom_issue_tracker.tracker", "issues_url", "https://tracker.example.com/issues/:id"),
+					resource.TestCheckResourceAttr("gitlab_service_custom_issue_tracker.tracker", "new_issue_url", "https://tracker.example.com/issues/new"),
+					resource.TestCheckResourceAttr("gitlab_service_custom_issue_tracker.tracker", "active", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_custom_issue_tracker.tracker",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the custom issue tracker service
+			{
+				Config: testAccGitlabServiceCustomIssueTrackerConfig(project.ID, "https://servicenow.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_custom_issue_tracker.tracker", "project_url", "https://servicenow.example.com/projects/foo"),
+					resource.TestCheckResourceAttr("gitlab_service_custom_issue_tracker.tracker", "issues_url", "https://servicenow.example.com/issues/:id"),
+					resource.TestCheckResourceAttr("gitlab_service_custom_issue_tracker.tracker", "new_issue_url", "https://servicenow.example.com/issues/new"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabServiceCustomIssueTrackerDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_service_custom_issue_tracker" {
+				continue
+			}
+
+			service, _, err := client.Services.GetCustomIssueTrackerService(rs.Primary.ID)
+			if err == nil {
+				if service.Active {
+					return fmt.Errorf("custom issue tracker service in project %s still exists", rs.Primary.ID)
+				}
+				continue
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabServiceCustomIssueTrackerConfig(project int, trackerURL string) string {
+	return fmt.Sprintf(`
+resource "gitlab_service_custom_issue_tracker" "tracker" {
+  project       = %[1]d
+  project_url   = "%[2]s/projects/foo"
+  issues_url    = "%[2]s/issues/:id"
+  new_issue_url = "%[2]s/issues/new"
+}`, project, trackerURL)
+}
@@ -0,0 +1,232 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabServiceDiscord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabServiceDiscordCreate,
+		Read:   resourceGitlabServiceDiscordRead,
+		Update: resourceGitlabServiceDiscordCreate,
+		Delete: resourceGitlabServiceDiscordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"webhook": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"notify_only_broken_pipelines": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"branches_to_be_notified": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"all", "default", "protected", "default_and_protected"}, false),
+			},
+			"push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"issues_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"confidential_issues_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"merge_requests_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"tag_push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"note_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"confidential_note_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"pipeline_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"wiki_page_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitlabServiceDiscordSetToState(d *schema.ResourceData, service *discordService) {
+	d.Set("webhook", service.Properties.WebHook)
+	d.Set("notify_only_broken_pipelines", bool(service.Properties.NotifyOnlyBrokenPipelines))
+	d.Set("branches_to_be_notified", service.Properties.BranchesToBeNotified)
+	d.Set("push_events", service.PushEvents)
+	d.Set("issues_events", service.IssuesEvents)
+	d.Set("confidential_issues_events", service.ConfidentialIssuesEvents)
+	d.Set("merge_requests_events", service.MergeRequestsEvents)
+	d.Set("tag_push_events", service.TagPushEvents)
+	d.Set("note_events", service.NoteEvents)
+	d.Set("confidential_note_events", service.ConfidentialNoteEvents)
+	d.Set("pipeline_events", service.PipelineEvents)
+	d.Set("wiki_page_events", service.WikiPageEvents)
+}
+
+func resourceGitlabServiceDiscordCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &setDiscordServiceOptions{
+		WebHook:                   gitlab.String(d.Get("webhook").(string)),
+		NotifyOnlyBrokenPipelines: gitlab.Bool(d.Get("notify_only_broken_pipelines").(bool)),
+		PushEvents:                gitlab.Bool(d.Get("push_events").(bool)),
+		IssuesEvents:              gitlab.Bool(d.Get("issues_events").(bool)),
+		ConfidentialIssuesEvents:  gitlab.Bool(d.Get("confidential_issues_events").(bool)),
+		MergeRequestsEvents:       gitlab.Bool(d.Get("merge_requests_events").(bool)),
+		TagPushEvents:             gitlab.Bool(d.Get("tag_push_events").(bool)),
+		NoteEvents:                gitlab.Bool(d.Get("note_events").(bool)),
+		ConfidentialNoteEvents:    gitlab.Bool(d.Get("confidential_note_events").(bool)),
+		PipelineEvents:            gitlab.Bool(d.Get("pipeline_events").(bool)),
+		WikiPageEvents:            gitlab.Bool(d.Get("wiki_page_events").(bool)),
+	}
+	if v, ok := d.GetOk("branches_to_be_notified"); ok {
+		options.BranchesToBeNotified = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab discord service for project %s", project)
+
+	_, err := setDiscordService(client, project, options)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(project)
+
+	return resourceGitlabServiceDiscordRead(d, meta)
+}
+
+func resourceGitlabServiceDiscordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab discord service for project %s", project)
+
+	service, _, err := getDiscordService(client, project)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab discord service for project %s not found so removing from state", project)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	resourceGitlabServiceDiscordSetToState(d, service)
+	return nil
+}
+
+func resourceGitlabServiceDiscordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab discord service for project %s", project)
+
+	req, err := client.NewRequest(http.MethodDelete, discordServicePath(project), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+// discordService represents the settings of the Discord service,
+// which is not supported by go-gitlab yet.
+type discordService struct {
+	gitlab.Service
+	Properties *discordServiceProperties `json:"properties"`
+}
+
+type discordServiceProperties struct {
+	WebHook                   string           `json:"webhook"`
+	NotifyOnlyBrokenPipelines gitlab.BoolValue `json:"notify_only_broken_pipelines"`
+	BranchesToBeNotified      string           `json:"branches_to_be_notified"`
+}
+
+type setDiscordServiceOptions struct {
+	WebHook                   *string `json:"webhook,omitempty"`
+	NotifyOnlyBrokenPipelines *bool   `json:"notify_only_broken_pipelines,omitempty"`
+	BranchesToBeNotified      *string `json:"branches_to_be_notified,omitempty"`
+	PushEvents                *bool   `json:"push_events,omitempty"`
+	IssuesEvents              *bool   `json:"issues_events,omitempty"`
+	ConfidentialIssuesEvents  *bool   `json:"confidential_issues_events,omitempty"`
+	MergeRequestsEvents       *bool   `json:"merge_requests_events,omitempty"`
+	TagPushEvents             *bool   `json:"tag_push_events,omitempty"`
+	NoteEvents                *bool   `json:"note_events,omitempty"`
+	ConfidentialNoteEvents    *bool   `json:"confidential_note_events,omitempty"`
+	PipelineEvents            *bool   `json:"pipeline_events,omitempty"`
+	WikiPageEvents            *bool   `json:"wiki_page_events,omitempty"`
+}
+
+func discordServicePath(project string) string {
+	return fmt.Sprintf("projects/%s/services/discord", url.PathEscape(project))
+}
+
+func getDiscordService(client *gitlab.Client, project string) (*discordService, *gitlab.Response, error) {
+	req, err := client.NewRequest(http.MethodGet, discordServicePath(project), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	service := new(discordService)
+	resp, err := client.Do(req, service)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return service, resp, nil
+}
+
+func setDiscordService(client *gitlab.Client, project string, options *setDiscordServiceOptions) (*gitlab.Response, error) {
+	req, err := client.NewRequest(http.MethodPut, discordServicePath(project), options, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(req, nil)
+}
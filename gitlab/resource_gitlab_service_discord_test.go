@@ -0,0 +1,97 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabServiceDiscord_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabServiceDiscordDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a Discord service with minimal settings
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_discord" "discord" {
+  project = %d
+  webhook = "https://discord.com/api/webhooks/minimal"
+}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_discord.discord", "webhook", "https://discord.com/api/webhooks/minimal"),
+					resource.TestCheckResourceAttrSet("gitlab_service_discord.discord", "branches_to_be_notified"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_discord.discord",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the Discord service with events
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_discord" "discord" {
+  project                      = %d
+  webhook                      = "https://discord.com/api/webhooks/events"
+  notify_only_broken_pipelines = true
+  branches_to_be_notified      = "all"
+  push_events                  = true
+  issues_events                = false
+  confidential_issues_events   = false
+  merge_requests_events        = true
+  tag_push_events              = false
+  note_events                  = false
+  confidential_note_events     = false
+  pipeline_events              = true
+  wiki_page_events             = false
+}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_discord.discord", "webhook", "https://discord.com/api/webhooks/events"),
+					resource.TestCheckResourceAttr("gitlab_service_discord.discord", "notify_only_broken_pipelines", "true"),
+					resource.TestCheckResourceAttr("gitlab_service_discord.discord", "branches_to_be_notified", "all"),
+					resource.TestCheckResourceAttr("gitlab_service_discord.discord", "push_events", "true"),
+					resource.TestCheckResourceAttr("gitlab_service_discord.discord", "issues_events", "false"),
+					resource.TestCheckResourceAttr("gitlab_service_discord.discord", "pipeline_events", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_discord.discord",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabServiceDiscordDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_service_discord" {
+				continue
+			}
+
+			service, _, err := getDiscordService(client, rs.Primary.ID)
+			if err == nil {
+				if service.Active {
+					return fmt.Errorf("discord service in project %s still exists", rs.Primary.ID)
+				}
+				continue
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
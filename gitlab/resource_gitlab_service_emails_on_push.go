@@ -0,0 +1,165 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabServiceEmailsOnPush() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabServiceEmailsOnPushCreate,
+		Read:   resourceGitlabServiceEmailsOnPushRead,
+		Update: resourceGitlabServiceEmailsOnPushCreate,
+		Delete: resourceGitlabServiceEmailsOnPushDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"recipients": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"disable_diffs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"send_from_committer_email": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"branches_to_be_notified": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"all", "default", "protected", "default_and_protected"}, false),
+				Default:      "all",
+			},
+		},
+	}
+}
+
+func resourceGitlabServiceEmailsOnPushSetToState(d *schema.ResourceData, service *emailsOnPushService) {
+	d.Set("recipients", strings.Fields(service.Properties.Recipients))
+	d.Set("disable_diffs", bool(service.Properties.DisableDiffs))
+	d.Set("send_from_committer_email", bool(service.Properties.SendFromCommitterEmail))
+	d.Set("branches_to_be_notified", service.Properties.BranchesToBeNotified)
+}
+
+func resourceGitlabServiceEmailsOnPushCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &setEmailsOnPushServiceOptions{
+		Recipients:             gitlab.String(strings.Join(*stringSetToStringSlice(d.Get("recipients").(*schema.Set)), " ")),
+		DisableDiffs:           gitlab.Bool(d.Get("disable_diffs").(bool)),
+		SendFromCommitterEmail: gitlab.Bool(d.Get("send_from_committer_email").(bool)),
+		BranchesToBeNotified:   gitlab.String(d.Get("branches_to_be_notified").(string)),
+	}
+
+	log.Printf("[DEBUG] create gitlab emails on push service for project %s", project)
+
+	req, err := client.NewRequest(http.MethodPut, emailsOnPushServicePath(project), options, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(project)
+
+	return resourceGitlabServiceEmailsOnPushRead(d, meta)
+}
+
+func resourceGitlabServiceEmailsOnPushRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab emails on push service for project %s", project)
+
+	service, _, err := getEmailsOnPushService(client, project)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab emails on push service for project %s not found so removing from state", project)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	resourceGitlabServiceEmailsOnPushSetToState(d, service)
+	return nil
+}
+
+func resourceGitlabServiceEmailsOnPushDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab emails on push service for project %s", project)
+
+	req, err := client.NewRequest(http.MethodDelete, emailsOnPushServicePath(project), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+// emailsOnPushService represents the settings of the Emails on push service,
+// which is not supported by go-gitlab yet.
+type emailsOnPushService struct {
+	gitlab.Service
+	Properties *emailsOnPushServiceProperties `json:"properties"`
+}
+
+type emailsOnPushServiceProperties struct {
+	Recipients             string           `json:"recipients"`
+	DisableDiffs           gitlab.BoolValue `json:"disable_diffs"`
+	SendFromCommitterEmail gitlab.BoolValue `json:"send_from_committer_email"`
+	BranchesToBeNotified   string           `json:"branches_to_be_notified"`
+}
+
+type setEmailsOnPushServiceOptions struct {
+	Recipients             *string `json:"recipients,omitempty"`
+	DisableDiffs           *bool   `json:"disable_diffs,omitempty"`
+	SendFromCommitterEmail *bool   `json:"send_from_committer_email,omitempty"`
+	BranchesToBeNotified   *string `json:"branches_to_be_notified,omitempty"`
+}
+
+func emailsOnPushServicePath(project string) string {
+	return fmt.Sprintf("projects/%s/services/emails-on-push", url.PathEscape(project))
+}
+
+func getEmailsOnPushService(client *gitlab.Client, project string) (*emailsOnPushService, *gitlab.Response, error) {
+	req, err := client.NewRequest(http.MethodGet, emailsOnPushServicePath(project), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	service := new(emailsOnPushService)
+	resp, err := client.Do(req, service)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return service, resp, nil
+}
@@ -0,0 +1,83 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabServiceEmailsOnPush_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabServiceEmailsOnPushDestroy(client),
+		Steps: []resource.TestStep{
+			// Create an emails on push service
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_emails_on_push" "email" {
+  project    = %d
+  recipients = ["test@example.com"]
+}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_emails_on_push.email", "recipients.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_service_emails_on_push.email", "disable_diffs", "false"),
+					resource.TestCheckResourceAttr("gitlab_service_emails_on_push.email", "send_from_committer_email", "false"),
+					resource.TestCheckResourceAttr("gitlab_service_emails_on_push.email", "branches_to_be_notified", "all"),
+				),
+			},
+			// Update the emails on push service
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_emails_on_push" "email" {
+  project                   = %d
+  recipients                = ["test@example.com", "test2@example.com"]
+  disable_diffs             = true
+  send_from_committer_email = true
+  branches_to_be_notified   = "protected"
+}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_emails_on_push.email", "recipients.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_service_emails_on_push.email", "disable_diffs", "true"),
+					resource.TestCheckResourceAttr("gitlab_service_emails_on_push.email", "send_from_committer_email", "true"),
+					resource.TestCheckResourceAttr("gitlab_service_emails_on_push.email", "branches_to_be_notified", "protected"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_emails_on_push.email",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabServiceEmailsOnPushDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_service_emails_on_push" {
+				continue
+			}
+
+			service, _, err := getEmailsOnPushService(client, rs.Primary.ID)
+			if err == nil {
+				if service.Active {
+					return fmt.Errorf("emails on push service in project %s still exists", rs.Primary.ID)
+				}
+				continue
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package gitlab
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabServiceJenkins() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabServiceJenkinsCreate,
+		Read:   resourceGitlabServiceJenkinsRead,
+		Update: resourceGitlabServiceJenkinsCreate,
+		Delete: resourceGitlabServiceJenkinsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"jenkins_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// The password is never returned by the API, so it is not read.
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"merge_requests_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"tag_push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitlabServiceJenkinsSetToState(d *schema.ResourceData, service *gitlab.JenkinsCIService) {
+	d.Set("jenkins_url", service.Properties.URL)
+	d.Set("project_name", service.Properties.ProjectName)
+	d.Set("username", service.Properties.Username)
+	d.Set("push_events", service.PushEvents)
+	d.Set("merge_requests_events", service.MergeRequestsEvents)
+	d.Set("tag_push_events", service.TagPushEvents)
+	d.Set("active", service.Active)
+}
+
+func resourceGitlabServiceJenkinsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.SetJenkinsCIServiceOptions{
+		URL:         gitlab.String(d.Get("jenkins_url").(string)),
+		ProjectName: gitlab.String(d.Get("project_name").(string)),
+		Username:    gitlab.String(d.Get("username").(string)),
+	}
+	if v, ok := d.GetOk("password"); ok {
+		options.Password = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOkExists("push_events"); ok {
+		options.PushEvents = gitlab.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("merge_requests_events"); ok {
+		options.MergeRequestsEvents = gitlab.Bool(v.(bool))
+	}
+	if v, ok := d.GetOkExists("tag_push_events"); ok {
+		options.TagPushEvents = gitlab.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] create gitlab jenkins service for project %s", project)
+
+	_, err := client.Services.SetJenkinsCIService(project, options)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(project)
+
+	return resourceGitlabServiceJenkinsRead(d, meta)
+}
+
+func resourceGitlabServiceJenkinsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab jenkins service for project %s", project)
+
+	service, _, err := client.Services.GetJenkinsCIService(project)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab jenkins service for project %s not found so removing from state", project)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	resourceGitlabServiceJenkinsSetToState(d, service)
+	return nil
+}
+
+func resourceGitlabServiceJenkinsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab jenkins service for project %s", project)
+
+	_, err := client.Services.DeleteJenkinsCIService(project)
+	return err
+}
@@ -0,0 +1,91 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabServiceJenkins_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabServiceJenkinsDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a Jenkins service
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_jenkins" "jenkins" {
+  project      = %d
+  jenkins_url  = "https://jenkins.example.com"
+  project_name = "foo"
+  username     = "gitlab"
+  password     = "secret"
+}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_jenkins.jenkins", "jenkins_url", "https://jenkins.example.com"),
+					resource.TestCheckResourceAttr("gitlab_service_jenkins.jenkins", "project_name", "foo"),
+					resource.TestCheckResourceAttr("gitlab_service_jenkins.jenkins", "username", "gitlab"),
+					resource.TestCheckResourceAttr("gitlab_service_jenkins.jenkins", "active", "true"),
+				),
+			},
+			// Verify import, the password is not returned by the API
+			{
+				ResourceName:            "gitlab_service_jenkins.jenkins",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Update the Jenkins service events
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_jenkins" "jenkins" {
+  project               = %d
+  jenkins_url           = "https://jenkins.example.com/ci"
+  project_name          = "bar"
+  username              = "gitlab"
+  password              = "secret"
+  push_events           = false
+  merge_requests_events = true
+  tag_push_events       = true
+}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_jenkins.jenkins", "jenkins_url", "https://jenkins.example.com/ci"),
+					resource.TestCheckResourceAttr("gitlab_service_jenkins.jenkins", "project_name", "bar"),
+					resource.TestCheckResourceAttr("gitlab_service_jenkins.jenkins", "push_events", "false"),
+					resource.TestCheckResourceAttr("gitlab_service_jenkins.jenkins", "merge_requests_events", "true"),
+					resource.TestCheckResourceAttr("gitlab_service_jenkins.jenkins", "tag_push_events", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabServiceJenkinsDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_service_jenkins" {
+				continue
+			}
+
+			service, _, err := client.Services.GetJenkinsCIService(rs.Primary.ID)
+			if err == nil {
+				if service.Active {
+					return fmt.Errorf("jenkins service in project %s still exists", rs.Primary.ID)
+				}
+				continue
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
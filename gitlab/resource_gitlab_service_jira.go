@@ -1,9 +1,11 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	gitlab "github.com/xanzy/go-gitlab"
@@ -46,6 +48,11 @@ func resourceGitlabServiceJira() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validateURLFunc,
 			},
+			"api_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURLFunc,
+			},
 			"project_key": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -64,6 +71,26 @@ func resourceGitlabServiceJira() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"jira_issue_transition_automatic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"issues_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"vulnerabilities_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"vulnerabilities_issuetype": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"commit_events": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -79,6 +106,36 @@ func resourceGitlabServiceJira() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"issues_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"tag_push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"note_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"pipeline_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"job_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -88,6 +145,10 @@ func resourceGitlabServiceJiraCreate(d *schema.ResourceData, meta interface{}) e
 
 	project := d.Get("project").(string)
 
+	if err := checkJiraServiceVersion(d, client); err != nil {
+		return err
+	}
+
 	jiraOptions, err := expandJiraOptions(d)
 	if err != nil {
 		return err
@@ -95,7 +156,12 @@ func resourceGitlabServiceJiraCreate(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[DEBUG] Create Gitlab Jira service")
 
-	if _, err := client.Services.SetJiraService(project, jiraOptions); err != nil {
+	req, err := client.NewRequest(http.MethodPut, jiraServicePath(project), jiraOptions, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
 		return fmt.Errorf("couldn't create Gitlab Jira service: %w", err)
 	}
 
@@ -120,23 +186,33 @@ func resourceGitlabServiceJiraRead(d *schema.ResourceData, meta interface{}) err
 
 	log.Printf("[DEBUG] Read Gitlab Jira service %s", d.Id())
 
-	jiraService, _, err := client.Services.GetJiraService(project)
+	req, err := client.NewRequest(http.MethodGet, jiraServicePath(project), nil, nil)
 	if err != nil {
 		return err
 	}
 
+	jiraService := new(jiraServiceSettings)
+	if _, err := client.Do(req, jiraService); err != nil {
+		return err
+	}
+
 	if v := jiraService.Properties.URL; v != "" {
 		d.Set("url", v)
 	}
 	if v := jiraService.Properties.Username; v != "" {
 		d.Set("username", v)
 	}
+	d.Set("api_url", jiraService.Properties.APIURL)
 	if v := jiraService.Properties.ProjectKey; v != "" {
 		d.Set("project_key", v)
 	}
 	if v := jiraService.Properties.JiraIssueTransitionID; v != "" {
 		d.Set("jira_issue_transition_id", v)
 	}
+	d.Set("jira_issue_transition_automatic", bool(jiraService.Properties.JiraIssueTransitionAutomatic))
+	d.Set("issues_enabled", bool(jiraService.Properties.IssuesEnabled))
+	d.Set("vulnerabilities_enabled", bool(jiraService.Properties.VulnerabilitiesEnabled))
+	d.Set("vulnerabilities_issuetype", jiraService.Properties.VulnerabilitiesIssuetype)
 
 	d.Set("title", jiraService.Title)
 	d.Set("created_at", jiraService.CreatedAt.String())
@@ -171,11 +247,12 @@ func resourceGitlabServiceJiraDelete(d *schema.ResourceData, meta interface{}) e
 	return err
 }
 
-func expandJiraOptions(d *schema.ResourceData) (*gitlab.SetJiraServiceOptions, error) {
-	setJiraServiceOptions := gitlab.SetJiraServiceOptions{}
+func expandJiraOptions(d *schema.ResourceData) (*setJiraServiceOptions, error) {
+	setJiraServiceOptions := setJiraServiceOptions{}
 
 	// Set required properties
 	setJiraServiceOptions.URL = gitlab.String(d.Get("url").(string))
+	setJiraServiceOptions.APIURL = gitlab.String(d.Get("api_url").(string))
 	setJiraServiceOptions.ProjectKey = gitlab.String(d.Get("project_key").(string))
 	setJiraServiceOptions.Username = gitlab.String(d.Get("username").(string))
 	setJiraServiceOptions.Password = gitlab.String(d.Get("password").(string))
@@ -188,6 +265,39 @@ func expandJiraOptions(d *schema.ResourceData) (*gitlab.SetJiraServiceOptions, e
 		setJiraServiceOptions.JiraIssueTransitionID = gitlab.String(val.(string))
 	}
 
+	// The newer properties are only sent when they are set,
+	// so that they work with the GitLab versions which do not support them.
+	if isJiraServiceOptionSet(d, "jira_issue_transition_automatic") {
+		setJiraServiceOptions.JiraIssueTransitionAutomatic = gitlab.Bool(d.Get("jira_issue_transition_automatic").(bool))
+	}
+	if isJiraServiceOptionSet(d, "issues_enabled") {
+		setJiraServiceOptions.IssuesEnabled = gitlab.Bool(d.Get("issues_enabled").(bool))
+	}
+	if isJiraServiceOptionSet(d, "vulnerabilities_enabled") {
+		setJiraServiceOptions.VulnerabilitiesEnabled = gitlab.Bool(d.Get("vulnerabilities_enabled").(bool))
+	}
+	if isJiraServiceOptionSet(d, "vulnerabilities_issuetype") {
+		setJiraServiceOptions.VulnerabilitiesIssuetype = gitlab.String(d.Get("vulnerabilities_issuetype").(string))
+	}
+	if isJiraServiceOptionSet(d, "push_events") {
+		setJiraServiceOptions.PushEvents = gitlab.Bool(d.Get("push_events").(bool))
+	}
+	if isJiraServiceOptionSet(d, "issues_events") {
+		setJiraServiceOptions.IssuesEvents = gitlab.Bool(d.Get("issues_events").(bool))
+	}
+	if isJiraServiceOptionSet(d, "tag_push_events") {
+		setJiraServiceOptions.TagPushEvents = gitlab.Bool(d.Get("tag_push_events").(bool))
+	}
+	if isJiraServiceOptionSet(d, "note_events") {
+		setJiraServiceOptions.NoteEvents = gitlab.Bool(d.Get("note_events").(bool))
+	}
+	if isJiraServiceOptionSet(d, "pipeline_events") {
+		setJiraServiceOptions.PipelineEvents = gitlab.Bool(d.Get("pipeline_events").(bool))
+	}
+	if isJiraServiceOptionSet(d, "job_events") {
+		setJiraServiceOptions.JobEvents = gitlab.Bool(d.Get("job_events").(bool))
+	}
+
 	return &setJiraServiceOptions, nil
 }
 
@@ -196,3 +306,92 @@ func resourceGitlabServiceJiraImportState(d *schema.ResourceData, meta interface
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// jiraServiceMinimumVersions are the GitLab versions which introduced
+// the newer properties of the Jira service.
+var jiraServiceMinimumVersions = []struct {
+	field   string
+	version string
+}{
+	{field: "issues_enabled", version: "13.2"},
+	{field: "vulnerabilities_enabled", version: "13.9"},
+	{field: "vulnerabilities_issuetype", version: "13.9"},
+	{field: "jira_issue_transition_automatic", version: "13.10"},
+}
+
+// isJiraServiceOptionSet returns true if the optional property is configured on create, or changed on update.
+// On create, a property set to its zero value, like false, does not count as changed.
+func isJiraServiceOptionSet(d *schema.ResourceData, key string) bool {
+	if d.IsNewResource() {
+		_, ok := d.GetOkExists(key)
+		return ok
+	}
+	return d.HasChange(key)
+}
+
+// checkJiraServiceVersion returns an error if a set property
+// is not supported by the version of GitLab.
+func checkJiraServiceVersion(d *schema.ResourceData, client *gitlab.Client) error {
+	for _, minimum := range jiraServiceMinimumVersions {
+		if !isJiraServiceOptionSet(d, minimum.field) {
+			continue
+		}
+
+		isAtLeast, err := isGitLabVersionAtLeast(client, minimum.version)()
+		if err != nil {
+			return err
+		}
+		if !isAtLeast {
+			return fmt.Errorf("%s of the Jira service requires GitLab %s or newer", minimum.field, minimum.version)
+		}
+	}
+
+	return nil
+}
+
+func jiraServicePath(project string) string {
+	return fmt.Sprintf("projects/%s/services/jira", url.PathEscape(project))
+}
+
+// jiraServiceSettings replaces gitlab.JiraService, which lacks the newer properties of the Jira service.
+type jiraServiceSettings struct {
+	gitlab.Service
+	Properties *jiraServiceProperties `json:"properties"`
+}
+
+type jiraServiceProperties struct {
+	gitlab.JiraServiceProperties
+	jiraServiceExtraProperties
+}
+
+type jiraServiceExtraProperties struct {
+	JiraIssueTransitionAutomatic gitlab.BoolValue `json:"jira_issue_transition_automatic"`
+	IssuesEnabled                gitlab.BoolValue `json:"issues_enabled"`
+	VulnerabilitiesEnabled       gitlab.BoolValue `json:"vulnerabilities_enabled"`
+	VulnerabilitiesIssuetype     string           `json:"vulnerabilities_issuetype"`
+}
+
+// UnmarshalJSON decodes the properties go-gitlab knows with its own decoder,
+// which handles the different types of the transition ID, and then the newer properties.
+func (p *jiraServiceProperties) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &p.JiraServiceProperties); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, &p.jiraServiceExtraProperties)
+}
+
+// setJiraServiceOptions extends gitlab.SetJiraServiceOptions with the newer properties and events of the Jira service.
+type setJiraServiceOptions struct {
+	gitlab.SetJiraServiceOptions
+	JiraIssueTransitionAutomatic *bool   `json:"jira_issue_transition_automatic,omitempty"`
+	IssuesEnabled                *bool   `json:"issues_enabled,omitempty"`
+	VulnerabilitiesEnabled       *bool   `json:"vulnerabilities_enabled,omitempty"`
+	VulnerabilitiesIssuetype     *string `json:"vulnerabilities_issuetype,omitempty"`
+	PushEvents                   *bool   `json:"push_events,omitempty"`
+	IssuesEvents                 *bool   `json:"issues_events,omitempty"`
+	TagPushEvents                *bool   `json:"tag_push_events,omitempty"`
+	NoteEvents                   *bool   `json:"note_events,omitempty"`
+	PipelineEvents               *bool   `json:"pipeline_events,omitempty"`
+	JobEvents                    *bool   `json:"job_events,omitempty"`
+}
@@ -1,11 +1,13 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	gitlab "github.com/xanzy/go-gitlab"
 )
@@ -90,6 +92,124 @@ func TestAccGitlabServiceJira_import(t *testing.T) {
 	})
 }
 
+func TestAccGitlabServiceJira_newerProperties(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+
+	// Automatic issue transitions are available since GitLab 13.10.
+	if isOld, err := isGitLabVersionLessThan(client, "13.10")(); err != nil {
+		t.Fatalf("could not check GitLab version: %v", err)
+	} else if isOld {
+		t.Skip("Test is skipped for GitLab versions older than 13.10")
+	}
+
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// Properties disabled on create are sent, so that the next plan is empty
+			{
+				Config: testAccGitlabServiceJiraNewerPropertiesConfig(project.ID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_jira.jira", "jira_issue_transition_automatic", "false"),
+					resource.TestCheckResourceAttr("gitlab_service_jira.jira", "push_events", "false"),
+				),
+			},
+			{
+				Config: testAccGitlabServiceJiraNewerPropertiesConfig(project.ID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_jira.jira", "api_url", "https://api.jira.example.com"),
+					resource.TestCheckResourceAttr("gitlab_service_jira.jira", "jira_issue_transition_automatic", "true"),
+					resource.TestCheckResourceAttr("gitlab_service_jira.jira", "push_events", "true"),
+				),
+			},
+			{
+				Config: testAccGitlabServiceJiraNewerPropertiesConfig(project.ID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_jira.jira", "jira_issue_transition_automatic", "false"),
+					resource.TestCheckResourceAttr("gitlab_service_jira.jira", "push_events", "false"),
+				),
+			},
+			{
+				ResourceName:            "gitlab_service_jira.jira",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestExpandJiraOptions(t *testing.T) {
+	raw := map[string]interface{}{
+		"project":        "1",
+		"url":            "https://jira.example.com",
+		"username":       "user1",
+		"password":       "mypass",
+		"push_events":    false,
+		"issues_enabled": false,
+	}
+
+	// On create, the properties set to false are sent.
+	d := schema.TestResourceDataRaw(t, resourceGitlabServiceJira().Schema, raw)
+	d.MarkNewResource()
+
+	options, err := expandJiraOptions(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if options.PushEvents == nil || *options.PushEvents {
+		t.Fatalf("expected push_events to be sent as false on create, got %v", options.PushEvents)
+	}
+	if options.IssuesEnabled == nil || *options.IssuesEnabled {
+		t.Fatalf("expected issues_enabled to be sent as false on create, got %v", options.IssuesEnabled)
+	}
+	if options.JobEvents != nil {
+		t.Fatalf("expected job_events not to be sent on create, got %v", *options.JobEvents)
+	}
+
+	// On update, the unchanged properties are not sent.
+	d = schema.TestResourceDataRaw(t, resourceGitlabServiceJira().Schema, raw)
+
+	options, err = expandJiraOptions(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if options.PushEvents != nil || options.IssuesEnabled != nil {
+		t.Fatalf("expected unchanged properties not to be sent on update, got %v and %v", options.PushEvents, options.IssuesEnabled)
+	}
+}
+
+func TestJiraServicePropertiesUnmarshalJSON(t *testing.T) {
+	var properties jiraServiceProperties
+	err := json.Unmarshal([]byte(`{
+		"url": "https://jira.example.com",
+		"jira_issue_transition_id": 3,
+		"jira_issue_transition_automatic": true,
+		"issues_enabled": "true",
+		"vulnerabilities_enabled": false,
+		"vulnerabilities_issuetype": "10001"
+	}`), &properties)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if properties.URL != "https://jira.example.com" {
+		t.Fatalf("expected url %q, got %q", "https://jira.example.com", properties.URL)
+	}
+	if properties.JiraIssueTransitionID != "3" {
+		t.Fatalf("expected jira_issue_transition_id %q, got %q", "3", properties.JiraIssueTransitionID)
+	}
+	if !properties.JiraIssueTransitionAutomatic || !properties.IssuesEnabled || properties.VulnerabilitiesEnabled {
+		t.Fatalf("unexpected boolean properties: %+v", properties.jiraServiceExtraProperties)
+	}
+	if properties.VulnerabilitiesIssuetype != "10001" {
+		t.Fatalf("expected vulnerabilities_issuetype %q, got %q", "10001", properties.VulnerabilitiesIssuetype)
+	}
+}
+
 func testAccCheckGitlabServiceJiraExists(n string, service *gitlab.JiraService) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -197,3 +317,17 @@ resource "gitlab_service_jira" "jira" {
 }
 `, rInt)
 }
+
+func testAccGitlabServiceJiraNewerPropertiesConfig(project int, enabled bool) string {
+	return fmt.Sprintf(`
+resource "gitlab_service_jira" "jira" {
+  project                         = %d
+  url                             = "https://jira.example.com"
+  api_url                         = "https://api.jira.example.com"
+  username                        = "user1"
+  password                        = "mypass"
+  jira_issue_transition_automatic = %[2]t
+  push_events                     = %[2]t
+}
+`, project, enabled)
+}
@@ -0,0 +1,228 @@
+package gitlab
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func resourceGitlabServiceMattermost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabServiceMattermostCreate,
+		Read:   resourceGitlabServiceMattermostRead,
+		Update: resourceGitlabServiceMattermostCreate,
+		Delete: resourceGitlabServiceMattermostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"webhook": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"notify_only_broken_pipelines": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"branches_to_be_notified": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"all", "default", "protected", "default_and_protected"}, false),
+			},
+			"push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"push_channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"issues_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"issue_channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"confidential_issues_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"confidential_issue_channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"merge_requests_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"merge_request_channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tag_push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"tag_push_channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"note_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"note_channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"confidential_note_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"pipeline_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"pipeline_channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"wiki_page_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"wiki_page_channel": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceGitlabServiceMattermostSetToState(d *schema.ResourceData, service *gitlab.MattermostService) {
+	d.Set("webhook", service.Properties.WebHook)
+	d.Set("username", service.Properties.Username)
+	d.Set("channel", service.Properties.Channel)
+	d.Set("notify_only_broken_pipelines", bool(service.Properties.NotifyOnlyBrokenPipelines))
+	d.Set("branches_to_be_notified", service.Properties.BranchesToBeNotified)
+	d.Set("push_events", service.PushEvents)
+	d.Set("push_channel", service.Properties.PushChannel)
+	d.Set("issues_events", service.IssuesEvents)
+	d.Set("issue_channel", service.Properties.IssueChannel)
+	d.Set("confidential_issues_events", service.ConfidentialIssuesEvents)
+	d.Set("confidential_issue_channel", service.Properties.ConfidentialIssueChannel)
+	d.Set("merge_requests_events", service.MergeRequestsEvents)
+	d.Set("merge_request_channel", service.Properties.MergeRequestChannel)
+	d.Set("tag_push_events", service.TagPushEvents)
+	d.Set("tag_push_channel", service.Properties.TagPushChannel)
+	d.Set("note_events", service.NoteEvents)
+	d.Set("note_channel", service.Properties.NoteChannel)
+	d.Set("confidential_note_events", service.ConfidentialNoteEvents)
+	d.Set("pipeline_events", service.PipelineEvents)
+	d.Set("pipeline_channel", service.Properties.PipelineChannel)
+	d.Set("wiki_page_events", service.WikiPageEvents)
+	d.Set("wiki_page_channel", service.Properties.WikiPageChannel)
+}
+
+func resourceGitlabServiceMattermostCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.SetMattermostServiceOptions{
+		WebHook:                   gitlab.String(d.Get("webhook").(string)),
+		Username:                  gitlab.String(d.Get("username").(string)),
+		Channel:                   gitlab.String(d.Get("channel").(string)),
+		NotifyOnlyBrokenPipelines: gitlab.Bool(d.Get("notify_only_broken_pipelines").(bool)),
+		PushEvents:                gitlab.Bool(d.Get("push_events").(bool)),
+		PushChannel:               gitlab.String(d.Get("push_channel").(string)),
+		IssuesEvents:              gitlab.Bool(d.Get("issues_events").(bool)),
+		IssueChannel:              gitlab.String(d.Get("issue_channel").(string)),
+		ConfidentialIssuesEvents:  gitlab.Bool(d.Get("confidential_issues_events").(bool)),
+		ConfidentialIssueChannel:  gitlab.String(d.Get("confidential_issue_channel").(string)),
+		MergeRequestsEvents:       gitlab.Bool(d.Get("merge_requests_events").(bool)),
+		MergeRequestChannel:       gitlab.String(d.Get("merge_request_channel").(string)),
+		TagPushEvents:             gitlab.Bool(d.Get("tag_push_events").(bool)),
+		TagPushChannel:            gitlab.String(d.Get("tag_push_channel").(string)),
+		NoteEvents:                gitlab.Bool(d.Get("note_events").(bool)),
+		NoteChannel:               gitlab.String(d.Get("note_channel").(string)),
+		ConfidentialNoteEvents:    gitlab.Bool(d.Get("confidential_note_events").(bool)),
+		PipelineEvents:            gitlab.Bool(d.Get("pipeline_events").(bool)),
+		PipelineChannel:           gitlab.String(d.Get("pipeline_channel").(string)),
+		WikiPageEvents:            gitlab.Bool(d.Get("wiki_page_events").(bool)),
+		WikiPageChannel:           gitlab.String(d.Get("wiki_page_channel").(string)),
+	}
+	if v, ok := d.GetOk("branches_to_be_notified"); ok {
+		options.BranchesToBeNotified = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab mattermost service for project %s", project)
+
+	_, err := client.Services.SetMattermostService(project, options)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(project)
+
+	return resourceGitlabServiceMattermostRead(d, meta)
+}
+
+func resourceGitlabServiceMattermostRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab mattermost service for project %s", project)
+
+	service, _, err := client.Services.GetMattermostService(project)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab mattermost service for project %s not found so removing from state", project)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	resourceGitlabServiceMattermostSetToState(d, service)
+	return nil
+}
+
+func resourceGitlabServiceMattermostDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab mattermost service for project %s", project)
+
+	_, err := client.Services.DeleteMattermostService(project)
+	return err
+}
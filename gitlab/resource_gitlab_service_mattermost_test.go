@@ -0,0 +1,124 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabServiceMattermost_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabServiceMattermostDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a Mattermost service with minimal settings
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_mattermost" "mattermost" {
+  project = %d
+  webhook = "https://mattermost.example.com/hooks/minimal"
+}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "webhook", "https://mattermost.example.com/hooks/minimal"),
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "push_channel", ""),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_mattermost.mattermost",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the Mattermost service with events and channels
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_mattermost" "mattermost" {
+  project                      = %d
+  webhook                      = "https://mattermost.example.com/hooks/channels"
+  username                     = "gitlab"
+  channel                      = "town-square"
+  notify_only_broken_pipelines = true
+  branches_to_be_notified      = "default_and_protected"
+  push_events                  = true
+  push_channel                 = "pushes"
+  issues_events                = true
+  issue_channel                = "issues"
+  confidential_issues_events   = true
+  confidential_issue_channel   = "confidential-issues"
+  merge_requests_events        = true
+  merge_request_channel        = "merge-requests"
+  tag_push_events              = true
+  tag_push_channel             = "tags"
+  note_events                  = true
+  note_channel                 = "notes"
+  confidential_note_events     = false
+  pipeline_events              = true
+  pipeline_channel             = "pipelines"
+  wiki_page_events             = true
+  wiki_page_channel            = "wiki"
+}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "webhook", "https://mattermost.example.com/hooks/channels"),
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "username", "gitlab"),
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "channel", "town-square"),
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "branches_to_be_notified", "default_and_protected"),
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "push_events", "true"),
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "push_channel", "pushes"),
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "merge_request_channel", "merge-requests"),
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "wiki_page_channel", "wiki"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_mattermost.mattermost",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove the per-event channels again
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_mattermost" "mattermost" {
+  project     = %d
+  webhook     = "https://mattermost.example.com/hooks/channels"
+  push_events = false
+}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "push_events", "false"),
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "push_channel", ""),
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "issue_channel", ""),
+					resource.TestCheckResourceAttr("gitlab_service_mattermost.mattermost", "channel", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabServiceMattermostDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_service_mattermost" {
+				continue
+			}
+
+			service, _, err := client.Services.GetMattermostService(rs.Primary.ID)
+			if err == nil {
+				if service.Active {
+					return fmt.Errorf("mattermost service in project %s still exists", rs.Primary.ID)
+				}
+				continue
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,97 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabServiceMicrosoftTeams_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabServiceMicrosoftTeamsDestroy(client),
+		Steps: []resource.TestStep{
+			// Create a Microsoft Teams service with minimal settings
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_microsoft_teams" "teams" {
+  project = %d
+  webhook = "https://example.webhook.office.com/webhookb2/minimal"
+}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_microsoft_teams.teams", "webhook", "https://example.webhook.office.com/webhookb2/minimal"),
+					resource.TestCheckResourceAttrSet("gitlab_service_microsoft_teams.teams", "branches_to_be_notified"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_microsoft_teams.teams",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the Microsoft Teams service with events
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_microsoft_teams" "teams" {
+  project                      = %d
+  webhook                      = "https://example.webhook.office.com/webhookb2/events"
+  notify_only_broken_pipelines = true
+  branches_to_be_notified      = "all"
+  push_events                  = true
+  issues_events                = false
+  confidential_issues_events   = false
+  merge_requests_events        = true
+  tag_push_events              = false
+  note_events                  = false
+  confidential_note_events     = false
+  pipeline_events              = true
+  wiki_page_events             = false
+}`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_microsoft_teams.teams", "webhook", "https://example.webhook.office.com/webhookb2/events"),
+					resource.TestCheckResourceAttr("gitlab_service_microsoft_teams.teams", "notify_only_broken_pipelines", "true"),
+					resource.TestCheckResourceAttr("gitlab_service_microsoft_teams.teams", "branches_to_be_notified", "all"),
+					resource.TestCheckResourceAttr("gitlab_service_microsoft_teams.teams", "push_events", "true"),
+					resource.TestCheckResourceAttr("gitlab_service_microsoft_teams.teams", "issues_events", "false"),
+					resource.TestCheckResourceAttr("gitlab_service_microsoft_teams.teams", "pipeline_events", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_microsoft_teams.teams",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabServiceMicrosoftTeamsDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_service_microsoft_teams" {
+				continue
+			}
+
+			service, _, err := client.Services.GetMicrosoftTeamsService(rs.Primary.ID)
+			if err == nil {
+				if service.Active {
+					return fmt.Errorf("microsoft teams service in project %s still exists", rs.Primary.ID)
+				}
+				continue
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
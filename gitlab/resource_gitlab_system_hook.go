@@ -0,0 +1,187 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// The system hooks API has no endpoint to edit a hook, so every argument forces a new hook.
+func resourceGitlabSystemHook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabSystemHookCreate,
+		Read:   resourceGitlabSystemHookRead,
+		Delete: resourceGitlabSystemHookDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"tag_push_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"merge_requests_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"repository_update_events": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"enable_ssl_verification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// systemHook extends gitlab.Hook with the event attributes not supported by go-gitlab yet.
+type systemHook struct {
+	gitlab.Hook
+	PushEvents             bool `json:"push_events"`
+	TagPushEvents          bool `json:"tag_push_events"`
+	MergeRequestsEvents    bool `json:"merge_requests_events"`
+	RepositoryUpdateEvents bool `json:"repository_update_events"`
+	EnableSSLVerification  bool `json:"enable_ssl_verification"`
+}
+
+var errSystemHookNotFound = errors.New("system hook not found")
+
+func resourceGitlabSystemHookCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	options := &gitlab.AddHookOptions{
+		URL:                    gitlab.String(d.Get("url").(string)),
+		PushEvents:             gitlab.Bool(d.Get("push_events").(bool)),
+		TagPushEvents:          gitlab.Bool(d.Get("tag_push_events").(bool)),
+		MergeRequestsEvents:    gitlab.Bool(d.Get("merge_requests_events").(bool)),
+		RepositoryUpdateEvents: gitlab.Bool(d.Get("repository_update_events").(bool)),
+		EnableSSLVerification:  gitlab.Bool(d.Get("enable_ssl_verification").(bool)),
+	}
+
+	if v, ok := d.GetOk("token"); ok {
+		options.Token = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab system hook %q", *options.URL)
+
+	hook, _, err := client.SystemHooks.AddHook(options)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", hook.ID))
+
+	return resourceGitlabSystemHookRead(d, meta)
+}
+
+func resourceGitlabSystemHookRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	hookID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] read gitlab system hook %d", hookID)
+
+	hook, err := getSystemHook(client, hookID)
+	if err != nil {
+		if errors.Is(err, errSystemHookNotFound) {
+			log.Printf("[DEBUG] gitlab system hook %d not found so removing from state", hookID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// The token is never returned by the API, so it is kept as configured.
+	d.Set("url", hook.URL)
+	d.Set("push_events", hook.PushEvents)
+	d.Set("tag_push_events", hook.TagPushEvents)
+	d.Set("merge_requests_events", hook.MergeRequestsEvents)
+	d.Set("repository_update_events", hook.RepositoryUpdateEvents)
+	d.Set("enable_ssl_verification", hook.EnableSSLVerification)
+	if hook.CreatedAt != nil {
+		d.Set("created_at", hook.CreatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceGitlabSystemHookDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	hookID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Delete gitlab system hook %s", d.Id())
+
+	_, err = client.SystemHooks.DeleteHook(hookID)
+	return err
+}
+
+// getSystemHook looks the hook up in the list of system hooks,
+// because GET /hooks/:id triggers a test event on older GitLab versions.
+func getSystemHook(client *gitlab.Client, hookID int) (*systemHook, error) {
+	options := &gitlab.ListOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	for options.Page != 0 {
+		req, err := client.NewRequest(http.MethodGet, "hooks", options, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var hooks []*systemHook
+		resp, err := client.Do(req, &hooks)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hook := range hooks {
+			if hook.ID == hookID {
+				return hook, nil
+			}
+		}
+
+		options.Page = resp.NextPage
+	}
+
+	return nil, errSystemHookNotFound
+}
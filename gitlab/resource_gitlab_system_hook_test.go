@@ -0,0 +1,168 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabSystemHook_basic(t *testing.T) {
+	var hook systemHook
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabSystemHookDestroy,
+		Steps: []resource.TestStep{
+			// Create a system hook with default options
+			{
+				Config: testAccGitlabSystemHookConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabSystemHookExists("gitlab_system_hook.foo", &hook),
+					testAccCheckGitlabSystemHookAttributes(&hook, &testAccGitlabSystemHookExpectedAttributes{
+						URL:                    fmt.Sprintf("https://example.com/hook-%d", rInt),
+						PushEvents:             true,
+						RepositoryUpdateEvents: true,
+						EnableSSLVerification:  true,
+					}),
+				),
+			},
+			// Verify import, the token is never returned by the API
+			{
+				ResourceName:            "gitlab_system_hook.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Re-create the system hook with all the values toggled to their inverse
+			{
+				Config: testAccGitlabSystemHookUpdateConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabSystemHookExists("gitlab_system_hook.foo", &hook),
+					testAccCheckGitlabSystemHookAttributes(&hook, &testAccGitlabSystemHookExpectedAttributes{
+						URL:                    fmt.Sprintf("https://example.com/hook-%d", rInt),
+						TagPushEvents:          true,
+						MergeRequestsEvents:    true,
+						RepositoryUpdateEvents: false,
+						EnableSSLVerification:  false,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabSystemHookExists(n string, hook *systemHook) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		hookID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		conn := testAccProvider.Meta().(*gitlab.Client)
+
+		gotHook, err := getSystemHook(conn, hookID)
+		if err != nil {
+			return err
+		}
+		*hook = *gotHook
+		return nil
+	}
+}
+
+type testAccGitlabSystemHookExpectedAttributes struct {
+	URL                    string
+	PushEvents             bool
+	TagPushEvents          bool
+	MergeRequestsEvents    bool
+	RepositoryUpdateEvents bool
+	EnableSSLVerification  bool
+}
+
+func testAccCheckGitlabSystemHookAttributes(hook *systemHook, want *testAccGitlabSystemHookExpectedAttributes) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if hook.URL != want.URL {
+			return fmt.Errorf("got url %q; want %q", hook.URL, want.URL)
+		}
+
+		if hook.PushEvents != want.PushEvents {
+			return fmt.Errorf("got push_events %t; want %t", hook.PushEvents, want.PushEvents)
+		}
+
+		if hook.TagPushEvents != want.TagPushEvents {
+			return fmt.Errorf("got tag_push_events %t; want %t", hook.TagPushEvents, want.TagPushEvents)
+		}
+
+		if hook.MergeRequestsEvents != want.MergeRequestsEvents {
+			return fmt.Errorf("got merge_requests_events %t; want %t", hook.MergeRequestsEvents, want.MergeRequestsEvents)
+		}
+
+		if hook.RepositoryUpdateEvents != want.RepositoryUpdateEvents {
+			return fmt.Errorf("got repository_update_events %t; want %t", hook.RepositoryUpdateEvents, want.RepositoryUpdateEvents)
+		}
+
+		if hook.EnableSSLVerification != want.EnableSSLVerification {
+			return fmt.Errorf("got enable_ssl_verification %t; want %t", hook.EnableSSLVerification, want.EnableSSLVerification)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGitlabSystemHookDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*gitlab.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_system_hook" {
+			continue
+		}
+
+		hookID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = getSystemHook(conn, hookID)
+		if err == nil {
+			return fmt.Errorf("System hook %d still exists", hookID)
+		}
+		if !errors.Is(err, errSystemHookNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccGitlabSystemHookConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_system_hook" "foo" {
+  url   = "https://example.com/hook-%d"
+  token = "secret-token-%d"
+}
+	`, rInt, rInt)
+}
+
+func testAccGitlabSystemHookUpdateConfig(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_system_hook" "foo" {
+  url                      = "https://example.com/hook-%d"
+  token                    = "secret-token-%d"
+  push_events              = false
+  tag_push_events          = true
+  merge_requests_events    = true
+  repository_update_events = false
+  enable_ssl_verification  = false
+}
+	`, rInt, rInt)
+}
@@ -0,0 +1,143 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// Tags cannot be edited, so every argument forces a new tag.
+func resourceGitlabTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabTagCreate,
+		Read:   resourceGitlabTagRead,
+		Delete: resourceGitlabTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// The API does not return the ref a tag was created from, so it is unknown after an import.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != "" && old == ""
+				},
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"protected": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"commit": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Set:      schema.HashResource(commitSchema),
+				Elem:     commitSchema,
+			},
+		},
+	}
+}
+
+// tag extends gitlab.Tag with the attributes not supported by go-gitlab yet.
+type tag struct {
+	gitlab.Tag
+	Protected bool `json:"protected"`
+}
+
+func resourceGitlabTagCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	name := d.Get("name").(string)
+	options := &gitlab.CreateTagOptions{
+		TagName: gitlab.String(name),
+		Ref:     gitlab.String(d.Get("ref").(string)),
+	}
+
+	if v, ok := d.GetOk("message"); ok {
+		options.Message = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab tag %q from ref %q in project %s", name, *options.Ref, project)
+
+	_, _, err := client.Tags.CreateTag(project, options)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&project, &name))
+
+	return resourceGitlabTagRead(d, meta)
+}
+
+func resourceGitlabTagRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab tag %q in project %s", name, project)
+
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/repository/tags/%s", url.PathEscape(project), url.PathEscape(name)), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	t := new(tag)
+	if _, err := client.Do(req, t); err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab tag %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("project", project)
+	d.Set("name", t.Name)
+	d.Set("message", t.Message)
+	d.Set("protected", t.Protected)
+	if err := d.Set("commit", flattenCommit(t.Commit)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceGitlabTagDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	project, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Delete gitlab tag %q in project %s", name, project)
+
+	_, err = client.Tags.DeleteTag(project, name)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package gitlab
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabTag_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabTagDestroy(client),
+		Steps: []resource.TestStep{
+			// Create an annotated tag
+			{
+				Config: testAccGitlabTagConfig(project, "v1.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_tag.foo", "name", "v1.0.0"),
+					resource.TestCheckResourceAttr("gitlab_tag.foo", "message", "Release v1.0.0"),
+					resource.TestCheckResourceAttr("gitlab_tag.foo", "protected", "false"),
+					resource.TestCheckResourceAttr("gitlab_tag.foo", "commit.#", "1"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_tag.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref"},
+			},
+			// Replace the tag by another one
+			{
+				Config: testAccGitlabTagConfig(project, "v1.0.1"),
+				Check:  resource.TestCheckResourceAttr("gitlab_tag.foo", "name", "v1.0.1"),
+			},
+		},
+	})
+}
+
+func TestAccGitlabTag_protected(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	project := testAccCreateProject(t, client)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabTagDestroy(client),
+		Steps: []resource.TestStep{
+			// Create and protect a tag in the same apply
+			{
+				Config: testAccGitlabTagProtectedConfig(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_tag_protection.foo", "tag", "v*"),
+					resource.TestCheckResourceAttr("gitlab_tag.foo", "protected", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabTagDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_tag" {
+				continue
+			}
+
+			project, name, err := parseTwoPartID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, _, err = client.Tags.GetTag(project, name)
+			if err == nil {
+				return fmt.Errorf("tag %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabTagConfig(project *gitlab.Project, name string) string {
+	return fmt.Sprintf(`
+resource "gitlab_tag" "foo" {
+  project = %d
+  name    = %q
+  ref     = %q
+  message = "Release %s"
+}`, project.ID, name, project.DefaultBranch, name)
+}
+
+func testAccGitlabTagProtectedConfig(project *gitlab.Project) string {
+	return fmt.Sprintf(`
+resource "gitlab_tag_protection" "foo" {
+  project             = %d
+  tag                 = "v*"
+  create_access_level = "maintainer"
+}
+
+resource "gitlab_tag" "foo" {
+  project = %d
+  name    = "v1.0.0"
+  ref     = %q
+
+  depends_on = [gitlab_tag_protection.foo]
+}`, project.ID, project.ID, project.DefaultBranch)
+}
@@ -0,0 +1,194 @@
+package gitlab
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// https://docs.gitlab.com/ee/api/users.html#list-all-gpg-keys
+
+// GPG keys cannot be edited, so every argument forces a new key.
+func resourceGitlabUserGPGKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabUserGPGKeyCreate,
+		Read:   resourceGitlabUserGPGKeyRead,
+		Delete: resourceGitlabUserGPGKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return normalizeGPGKey(old) == normalizeGPGKey(new)
+				},
+			},
+			"key_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// gpgKey represents a GPG key, which is not supported by go-gitlab yet.
+type gpgKey struct {
+	ID        int        `json:"id"`
+	Key       string     `json:"key"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// addGPGKeyOptions represents the options to add a GPG key, which are not supported by go-gitlab yet.
+type addGPGKeyOptions struct {
+	Key *string `url:"key,omitempty" json:"key,omitempty"`
+}
+
+func resourceGitlabUserGPGKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	options := &addGPGKeyOptions{
+		Key: gitlab.String(strings.TrimSpace(d.Get("key").(string))),
+	}
+
+	// Without user_id, the key is added to the current user, which does not require administrator access.
+	path := "user/gpg_keys"
+	userID, ok := d.GetOk("user_id")
+	if ok {
+		path = fmt.Sprintf("users/%d/gpg_keys", userID.(int))
+	} else {
+		currentUser, _, err := client.Users.CurrentUser()
+		if err != nil {
+			return err
+		}
+		userID = currentUser.ID
+	}
+
+	log.Printf("[DEBUG] create gitlab GPG key for user %d", userID.(int))
+
+	req, err := client.NewRequest(http.MethodPost, path, options, nil)
+	if err != nil {
+		return err
+	}
+
+	key := new(gpgKey)
+	if _, err := client.Do(req, key); err != nil {
+		return err
+	}
+
+	userIDString := strconv.Itoa(userID.(int))
+	keyIDString := strconv.Itoa(key.ID)
+	d.SetId(buildTwoPartID(&userIDString, &keyIDString))
+
+	return resourceGitlabUserGPGKeyRead(d, meta)
+}
+
+func resourceGitlabUserGPGKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, keyID, err := parseUserKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab GPG key %d of user %d", keyID, userID)
+
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("users/%d/gpg_keys/%d", userID, keyID), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	key := new(gpgKey)
+	if _, err := client.Do(req, key); err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab GPG key %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("user_id", userID)
+	d.Set("key_id", key.ID)
+	d.Set("key", key.Key)
+	if key.CreatedAt != nil {
+		d.Set("created_at", key.CreatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceGitlabUserGPGKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, keyID, err := parseUserKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Delete gitlab GPG key %d of user %d", keyID, userID)
+
+	isCurrent, err := isCurrentUser(client, userID)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("users/%d/gpg_keys/%d", userID, keyID)
+	if isCurrent {
+		path = fmt.Sprintf("user/gpg_keys/%d", keyID)
+	}
+
+	req, err := client.NewRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+// normalizeGPGKey returns the armored GPG public key without its armor headers, such as Comment and Version,
+// and without the whitespace surrounding its lines.
+func normalizeGPGKey(key string) string {
+	var lines []string
+	inHeaders := false
+
+	for _, line := range strings.Split(strings.TrimSpace(key), "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(line, "-----BEGIN "):
+			inHeaders = true
+		case inHeaders && line == "":
+			inHeaders = false
+			continue
+		case inHeaders && strings.Contains(line, ": "):
+			continue
+		default:
+			inHeaders = false
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
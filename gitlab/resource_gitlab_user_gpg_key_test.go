@@ -0,0 +1,116 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+const testAccGitlabUserGPGKeyPublicKey = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEatLFUxYJKwYBBAHaRw8BAQdA/mePKSgLMi0wltpoTwFVsM5D6qyi0e+tbRh7
+/rugea20KlRlcnJhZm9ybSBBY2NlcHRhbmNlIDxhY2N0ZXN0QGV4YW1wbGUuY29t
+PoiQBBMWCAA4FiEE6K/eLst4iVZdrNqW9fKHqz4jo3QFAmrSxVMCGwMFCwkIBwIG
+FQoJCAsCBBYCAwECHgECF4AACgkQ9fKHqz4jo3R+1wEAkfIetaZ6lWOeRDopyhMw
+T80ZvjHiziSi1+mPPsnHoc0A/iy1N1P8inbhqN2kbmBT8Plx6KFb49Jteg8XUEHS
+KTcO
+=xy62
+-----END PGP PUBLIC KEY BLOCK-----`
+
+func TestAccGitlabUserGPGKey_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	user := testAccCreateUsers(t, client, 1)[0]
+
+	// The same key with an armor header and trailing whitespace.
+	keyWithComment := strings.Replace(testAccGitlabUserGPGKeyPublicKey, "\n\n", "\nComment: acctest\n\n", 1) + "\n"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabUserGPGKeyDestroy(client),
+		Steps: []resource.TestStep{
+			// Add a key to the user
+			{
+				Config: testAccGitlabUserGPGKeyConfig(user.ID, keyWithComment),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_user_gpg_key.foo", "key_id"),
+					resource.TestCheckResourceAttrSet("gitlab_user_gpg_key.foo", "created_at"),
+				),
+			},
+			// The armor headers and trailing whitespace of the key are ignored
+			{
+				Config:   testAccGitlabUserGPGKeyConfig(user.ID, testAccGitlabUserGPGKeyPublicKey),
+				PlanOnly: true,
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_user_gpg_key.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestNormalizeGPGKey(t *testing.T) {
+	expected := normalizeGPGKey(testAccGitlabUserGPGKeyPublicKey)
+
+	cases := []string{
+		testAccGitlabUserGPGKeyPublicKey + "\n\n",
+		strings.Replace(testAccGitlabUserGPGKeyPublicKey, "\n\n", "\nComment: acctest\nVersion: GnuPG v2\n\n", 1),
+		strings.ReplaceAll(testAccGitlabUserGPGKeyPublicKey, "\n", "\r\n"),
+	}
+
+	for _, key := range cases {
+		if got := normalizeGPGKey(key); got != expected {
+			t.Fatalf("got %q expected %q", got, expected)
+		}
+	}
+
+	if normalizeGPGKey(strings.Replace(testAccGitlabUserGPGKeyPublicKey, "KTcO", "KTcP", 1)) == expected {
+		t.Fatalf("expected different keys not to be equal")
+	}
+}
+
+func testAccCheckGitlabUserGPGKeyDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_user_gpg_key" {
+				continue
+			}
+
+			userID, keyID, err := parseUserKeyID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("users/%d/gpg_keys/%d", userID, keyID), nil, nil)
+			if err != nil {
+				return err
+			}
+
+			_, err = client.Do(req, nil)
+			if err == nil {
+				return fmt.Errorf("GPG key %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabUserGPGKeyConfig(userID int, key string) string {
+	return fmt.Sprintf(`
+resource "gitlab_user_gpg_key" "foo" {
+  user_id = %d
+  key     = %q
+}`, userID, key)
+}
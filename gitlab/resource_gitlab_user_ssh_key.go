@@ -0,0 +1,258 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// SSH keys cannot be edited, so every argument forces a new key.
+func resourceGitlabUserSSHKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitlabUserSSHKeyCreate,
+		Read:   resourceGitlabUserSSHKeyRead,
+		Delete: resourceGitlabUserSSHKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return normalizeSSHKey(old) == normalizeSSHKey(new)
+				},
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: expiresAtSuppressFunc,
+			},
+			"key_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// sshKey extends gitlab.SSHKey with the attributes not supported by go-gitlab yet.
+type sshKey struct {
+	gitlab.SSHKey
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// addSSHKeyOptions replaces gitlab.AddSSHKeyOptions, which only supports expiry dates without time.
+type addSSHKeyOptions struct {
+	Title     *string    `url:"title,omitempty" json:"title,omitempty"`
+	Key       *string    `url:"key,omitempty" json:"key,omitempty"`
+	ExpiresAt *time.Time `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+var errUserSSHKeyNotFound = errors.New("user SSH key not found")
+
+func resourceGitlabUserSSHKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	options := &addSSHKeyOptions{
+		Title: gitlab.String(d.Get("title").(string)),
+		Key:   gitlab.String(strings.TrimSpace(d.Get("key").(string))),
+	}
+
+	if v, ok := d.GetOk("expires_at"); ok {
+		expiresAt, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Invalid expires_at date: %v", err)
+		}
+		options.ExpiresAt = &expiresAt
+	}
+
+	// Without user_id, the key is added to the current user, which does not require administrator access.
+	path := "user/keys"
+	userID, ok := d.GetOk("user_id")
+	if ok {
+		path = fmt.Sprintf("users/%d/keys", userID.(int))
+	} else {
+		currentUser, _, err := client.Users.CurrentUser()
+		if err != nil {
+			return err
+		}
+		userID = currentUser.ID
+	}
+
+	log.Printf("[DEBUG] create gitlab SSH key %q for user %d", *options.Title, userID.(int))
+
+	req, err := client.NewRequest(http.MethodPost, path, options, nil)
+	if err != nil {
+		return err
+	}
+
+	key := new(sshKey)
+	if _, err := client.Do(req, key); err != nil {
+		return err
+	}
+
+	userIDString := strconv.Itoa(userID.(int))
+	keyIDString := strconv.Itoa(key.ID)
+	d.SetId(buildTwoPartID(&userIDString, &keyIDString))
+
+	return resourceGitlabUserSSHKeyRead(d, meta)
+}
+
+func resourceGitlabUserSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, keyID, err := parseUserKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] read gitlab SSH key %d of user %d", keyID, userID)
+
+	key, err := getUserSSHKey(client, userID, keyID)
+	if err != nil {
+		if is404(err) || errors.Is(err, errUserSSHKeyNotFound) {
+			log.Printf("[DEBUG] gitlab SSH key %s not found so removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("user_id", userID)
+	d.Set("key_id", key.ID)
+	d.Set("title", key.Title)
+	d.Set("key", key.Key)
+	if key.ExpiresAt != nil {
+		d.Set("expires_at", key.ExpiresAt.Format(time.RFC3339))
+	} else {
+		d.Set("expires_at", "")
+	}
+	if key.CreatedAt != nil {
+		d.Set("created_at", key.CreatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceGitlabUserSSHKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+	userID, keyID, err := parseUserKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Delete gitlab SSH key %d of user %d", keyID, userID)
+
+	isCurrent, err := isCurrentUser(client, userID)
+	if err != nil {
+		return err
+	}
+
+	if isCurrent {
+		_, err = client.Users.DeleteSSHKey(keyID)
+	} else {
+		_, err = client.Users.DeleteSSHKeyForUser(userID, keyID)
+	}
+	if err != nil && !is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+// getUserSSHKey looks the key up in the list of SSH keys of the user, which is available to every user,
+// because older GitLab versions have no endpoint to get a single SSH key of another user.
+func getUserSSHKey(client *gitlab.Client, userID, keyID int) (*sshKey, error) {
+	options := &gitlab.ListOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	for options.Page != 0 {
+		req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("users/%d/keys", userID), options, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var keys []*sshKey
+		resp, err := client.Do(req, &keys)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range keys {
+			if key.ID == keyID {
+				return key, nil
+			}
+		}
+
+		options.Page = resp.NextPage
+	}
+
+	return nil, errUserSSHKeyNotFound
+}
+
+// normalizeSSHKey returns the type and the base64 encoded data of an SSH public key,
+// ignoring its comment and surrounding whitespace.
+func normalizeSSHKey(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) > 2 {
+		fields = fields[:2]
+	}
+	return strings.Join(fields, " ")
+}
+
+// isCurrentUser returns true if the given user is the user authenticated by the provider token.
+func isCurrentUser(client *gitlab.Client, userID int) (bool, error) {
+	currentUser, _, err := client.Users.CurrentUser()
+	if err != nil {
+		return false, err
+	}
+
+	return currentUser.ID == userID, nil
+}
+
+// parseUserKeyID parses the `user_id:key_id` ID of a user SSH or GPG key.
+func parseUserKeyID(id string) (int, int, error) {
+	rawUserID, rawKeyID, err := parseTwoPartID(id)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	userID, err := strconv.Atoi(rawUserID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Unexpected user ID %q in ID %q: %w", rawUserID, id, err)
+	}
+
+	keyID, err := strconv.Atoi(rawKeyID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("Unexpected key ID %q in ID %q: %w", rawKeyID, id, err)
+	}
+
+	return userID, keyID, nil
+}
@@ -0,0 +1,108 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+const testAccGitlabUserSSHKeyPublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCj13ozEBZ0s4el4k6mYqoyIKKKMh9hHY0sAYqSPXs2zGuVFZss1P8TPuwmdXVjHR7TiRXwC49zDrkyWJgiufggYJ1VilOohcMOODwZEJz+E5q4GCfHuh90UEh0nl8B2R0Uoy0LPeg93uZzy0hlHApsxRf/XZJz/1ytkZvCtxdllxfImCVxJReMeRVEqFCTCvy3YuJn0bce7ulcTFRvtgWOpQsr6GDK8YkcCCv2eZthVlrEwy6DEpAKTRiRLGgUj4dPO0MmO4cE2qD4ualY01PhNORJ8Q++I+EtkGt/VALkecwFuBkl18/gy+yxNJHpKc/8WVVinDeFrd/HhiY9yU0d"
+
+func TestAccGitlabUserSSHKey_basic(t *testing.T) {
+	testAccCheck(t)
+
+	client := testAccNewClient(t)
+	user := testAccCreateUsers(t, client, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGitlabUserSSHKeyDestroy(client),
+		Steps: []resource.TestStep{
+			// Add a key with a comment and trailing whitespace to the user
+			{
+				Config: testAccGitlabUserSSHKeyConfig(user.ID, testAccGitlabUserSSHKeyPublicKey+" acctest@example.com\n", "2030-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user_ssh_key.foo", "title", "acctest"),
+					resource.TestCheckResourceAttr("gitlab_user_ssh_key.foo", "expires_at", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet("gitlab_user_ssh_key.foo", "key_id"),
+					resource.TestCheckResourceAttrSet("gitlab_user_ssh_key.foo", "created_at"),
+				),
+			},
+			// The comment and trailing whitespace of the key are ignored
+			{
+				Config:   testAccGitlabUserSSHKeyConfig(user.ID, testAccGitlabUserSSHKeyPublicKey, "2030-01-01T00:00:00Z"),
+				PlanOnly: true,
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_user_ssh_key.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestNormalizeSSHKey(t *testing.T) {
+	cases := []struct {
+		Key      string
+		Expected string
+	}{
+		{
+			Key:      "ssh-ed25519 AAAAC3Nza",
+			Expected: "ssh-ed25519 AAAAC3Nza",
+		},
+		{
+			Key:      "  ssh-ed25519 AAAAC3Nza user@example.com \n",
+			Expected: "ssh-ed25519 AAAAC3Nza",
+		},
+		{
+			Key:      "ssh-ed25519 AAAAC3Nza a comment with spaces",
+			Expected: "ssh-ed25519 AAAAC3Nza",
+		},
+	}
+
+	for _, tc := range cases {
+		if got := normalizeSSHKey(tc.Key); got != tc.Expected {
+			t.Fatalf("got %q expected %q", got, tc.Expected)
+		}
+	}
+}
+
+func testAccCheckGitlabUserSSHKeyDestroy(client *gitlab.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "gitlab_user_ssh_key" {
+				continue
+			}
+
+			userID, keyID, err := parseUserKeyID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = getUserSSHKey(client, userID, keyID)
+			if err == nil {
+				return fmt.Errorf("SSH key %s still exists", rs.Primary.ID)
+			}
+			if !is404(err) && !errors.Is(err, errUserSSHKeyNotFound) {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func testAccGitlabUserSSHKeyConfig(userID int, key, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "gitlab_user_ssh_key" "foo" {
+  user_id    = %d
+  title      = "acctest"
+  key        = %q
+  expires_at = %q
+}`, userID, key, expiresAt)
+}
@@ -1,7 +1,9 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
@@ -9,6 +11,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	gitlab "github.com/xanzy/go-gitlab"
 )
 
@@ -225,6 +228,12 @@ var accessLevel = map[gitlab.AccessLevelValue]string{
 	gitlab.OwnerPermission:       "owner",
 }
 
+// is404 returns true if the error is a GitLab API error response with the 404 Not Found status code.
+func is404(err error) bool {
+	var httpErr *gitlab.ErrorResponse
+	return errors.As(err, &httpErr) && httpErr.Response.StatusCode == http.StatusNotFound
+}
+
 func stringSetToStringSlice(stringSet *schema.Set) *[]string {
 	ret := []string{}
 	if stringSet == nil {
@@ -272,6 +281,16 @@ func isGitLabVersionAtLeast(client *gitlab.Client, wantVersion string) func() (b
 	}
 }
 
+// isGitLabEE returns true if the GitLab instance runs the Enterprise Edition, whose version has the "-ee" suffix.
+func isGitLabEE(client *gitlab.Client) (bool, error) {
+	version, _, err := client.Version.GetVersion()
+	if err != nil {
+		return false, err
+	}
+
+	return strings.HasSuffix(version.Version, "-ee"), nil
+}
+
 func parseVersionMajorMinor(version string) (int, int, error) {
 	parts := strings.SplitN(version, ".", 3)
 	if len(parts) < 2 {
@@ -290,3 +309,82 @@ func parseVersionMajorMinor(version string) (int, int, error) {
 
 	return major, minor, nil
 }
+
+// withTokenRotation adds the attributes to rotate a token before it expires to a token resource.
+// Tokens cannot be edited, so every argument of a token resource forces a new token, except for rotate_before_days.
+// It is not stored in GitLab, so updating it only reads the token again.
+func withTokenRotation(r *schema.Resource) *schema.Resource {
+	for k, v := range tokenRotationSchema() {
+		r.Schema[k] = v
+	}
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		return r.Read(d, meta)
+	}
+	r.CustomizeDiff = customizeTokenRotationDiff
+
+	return r
+}
+
+// tokenRotationSchema returns the schema of the attributes to rotate a token before it expires.
+// They are set by setTokenRotationState and used by customizeTokenRotationDiff.
+func tokenRotationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"rotate_before_days": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"ready_for_rotation": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+// setTokenRotationState sets whether the token is ready for rotation,
+// because it expires within the number of days given by rotate_before_days.
+func setTokenRotationState(d *schema.ResourceData) {
+	d.Set("ready_for_rotation", isTokenReadyForRotation(d.Get("expires_at").(string), d.Get("rotate_before_days").(int), time.Now()))
+}
+
+// customizeTokenRotationDiff plans to replace a token which is ready for rotation.
+// Whether the token is ready is computed from the planned expires_at and rotate_before_days,
+// so that disabling the rotation or moving the expiry date out of the window does not replace the token.
+func customizeTokenRotationDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("expires_at") {
+		return nil
+	}
+
+	ready := isTokenReadyForRotation(d.Get("expires_at").(string), d.Get("rotate_before_days").(int), time.Now())
+	if !ready {
+		if d.Get("ready_for_rotation").(bool) {
+			return d.SetNew("ready_for_rotation", false)
+		}
+		return nil
+	}
+
+	// ForceNew only accepts a changed key, so the stored value is flipped. This also covers tokens which are
+	// not yet marked as ready, for example when rotate_before_days is added to a token which is about to expire.
+	if err := d.SetNew("ready_for_rotation", !d.Get("ready_for_rotation").(bool)); err != nil {
+		return err
+	}
+	return d.ForceNew("ready_for_rotation")
+}
+
+// isTokenReadyForRotation returns true if the token expires within rotateBeforeDays days from now.
+// The expiry date is either in the YYYY-MM-DD or in the RFC3339 format. Tokens without expiry date are never rotated.
+func isTokenReadyForRotation(expiresAt string, rotateBeforeDays int, now time.Time) bool {
+	if expiresAt == "" || rotateBeforeDays <= 0 {
+		return false
+	}
+
+	expiry, err := time.Parse("2006-01-02", expiresAt)
+	if err != nil {
+		expiry, err = time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return false
+		}
+	}
+
+	return !now.AddDate(0, 0, rotateBeforeDays).Before(expiry)
+}
@@ -1,8 +1,13 @@
 package gitlab
 
 import (
+	"fmt"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	gitlab "github.com/xanzy/go-gitlab"
 )
 
@@ -99,3 +104,156 @@ func TestValidateURLFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestIsTokenReadyForRotation(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		ExpiresAt        string
+		RotateBeforeDays int
+		Expected         bool
+	}{
+		{
+			ExpiresAt:        "",
+			RotateBeforeDays: 7,
+			Expected:         false,
+		},
+		{
+			ExpiresAt:        "2021-06-05",
+			RotateBeforeDays: 0,
+			Expected:         false,
+		},
+		{
+			ExpiresAt:        "2021-06-30",
+			RotateBeforeDays: 7,
+			Expected:         false,
+		},
+		{
+			ExpiresAt:        "2021-06-05",
+			RotateBeforeDays: 7,
+			Expected:         true,
+		},
+		{
+			ExpiresAt:        "2021-05-01",
+			RotateBeforeDays: 7,
+			Expected:         true,
+		},
+		{
+			ExpiresAt:        "2021-06-05T00:00:00Z",
+			RotateBeforeDays: 7,
+			Expected:         true,
+		},
+		{
+			ExpiresAt:        "2021-06-30T00:00:00Z",
+			RotateBeforeDays: 7,
+			Expected:         false,
+		},
+		{
+			ExpiresAt:        "invalid",
+			RotateBeforeDays: 7,
+			Expected:         false,
+		},
+	}
+
+	for _, tc := range cases {
+		got := isTokenReadyForRotation(tc.ExpiresAt, tc.RotateBeforeDays, now)
+		if got != tc.Expected {
+			t.Fatalf("expires at %q with rotate_before_days %d: got %v expected %v", tc.ExpiresAt, tc.RotateBeforeDays, got, tc.Expected)
+		}
+	}
+}
+
+func TestCustomizeTokenRotationDiff(t *testing.T) {
+	soon := time.Now().AddDate(0, 0, 3).Format("2006-01-02")
+	later := time.Now().AddDate(0, 2, 0).Format("2006-01-02")
+
+	cases := []struct {
+		Name             string
+		ExpiresAt        string
+		RotateBeforeDays int
+		StateRotateDays  string
+		StateReady       bool
+		RequiresNew      bool
+	}{
+		{
+			Name:             "token ready for rotation",
+			ExpiresAt:        soon,
+			RotateBeforeDays: 7,
+			StateReady:       true,
+			RequiresNew:      true,
+		},
+		{
+			Name:             "rotation enabled for a token within the window",
+			ExpiresAt:        soon,
+			RotateBeforeDays: 7,
+			StateRotateDays:  "0",
+			StateReady:       false,
+			RequiresNew:      true,
+		},
+		{
+			Name:             "token entered the window without a refresh",
+			ExpiresAt:        soon,
+			RotateBeforeDays: 7,
+			StateReady:       false,
+			RequiresNew:      true,
+		},
+		{
+			Name:             "rotation disabled",
+			ExpiresAt:        soon,
+			RotateBeforeDays: 0,
+			StateReady:       true,
+			RequiresNew:      false,
+		},
+		{
+			Name:             "rotation window reduced",
+			ExpiresAt:        soon,
+			RotateBeforeDays: 1,
+			StateReady:       true,
+			RequiresNew:      false,
+		},
+		{
+			Name:             "token not ready for rotation",
+			ExpiresAt:        later,
+			RotateBeforeDays: 7,
+			StateReady:       false,
+			RequiresNew:      false,
+		},
+	}
+
+	r := resourceGitlabPersonalAccessToken()
+	for _, tc := range cases {
+		stateRotateDays := tc.StateRotateDays
+		if stateRotateDays == "" {
+			stateRotateDays = "7"
+		}
+		state := &terraform.InstanceState{
+			ID: "1:2",
+			Attributes: map[string]string{
+				"id":       "1:2",
+				"user_id":  "1",
+				"name":     "foo",
+				"scopes.#": "1",
+				fmt.Sprintf("scopes.%d", schema.HashString("api")): "api",
+				"expires_at":         tc.ExpiresAt,
+				"impersonation":      "false",
+				"rotate_before_days": stateRotateDays,
+				"ready_for_rotation": strconv.FormatBool(tc.StateReady),
+			},
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"user_id":            1,
+			"name":               "foo",
+			"scopes":             []interface{}{"api"},
+			"expires_at":         tc.ExpiresAt,
+			"rotate_before_days": tc.RotateBeforeDays,
+		})
+
+		diff, err := r.Diff(state, config, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.Name, err)
+		}
+		if got := diff != nil && diff.RequiresNew(); got != tc.RequiresNew {
+			t.Fatalf("%s: got requires new %v expected %v", tc.Name, got, tc.RequiresNew)
+		}
+	}
+}
@@ -9,4 +9,5 @@ require (
 	github.com/mitchellh/hashstructure v1.0.0
 	github.com/onsi/gomega v1.14.0
 	github.com/xanzy/go-gitlab v0.50.0
+	golang.org/x/time v0.0.0-20191024005414-555d28b269f0
 )